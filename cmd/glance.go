@@ -14,6 +14,7 @@ limitations under the License.
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -23,6 +24,14 @@ import (
 func main() {
 	root := cmd.NewGlanceCmd()
 	if err := root.Execute(); err != nil {
+		// Commands such as "glance check" choose their own exit status.
+		var exitErr *cmd.ExitError
+		if errors.As(err, &exitErr) {
+			if exitErr.Err != nil {
+				fmt.Fprintln(os.Stderr, exitErr.Err.Error())
+			}
+			os.Exit(exitErr.Code)
+		}
 		// Print a user-facing error to stderr and exit with non-zero status.
 		// Logging is handled inside the library code via configured logrus.
 		fmt.Fprintln(os.Stderr, err.Error())
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// webAssets holds the static dashboard served at / by "glance serve --http".
+//
+//go:embed web
+var webAssets embed.FS
+
+// apiServer serves the JSON API under /api/v1/. Every request is answered
+// from the same collectors as the CLI, so the API and the static views agree.
+type apiServer struct {
+	client        kubernetes.Interface
+	metricsSource metricsource.Source
+	metricsMode   string
+	// snapshot collects the glance/v1 document printed by "glance -o json".
+	snapshot func(ctx context.Context) (*core.SnapshotDocument, error)
+}
+
+// register adds the API endpoints and the web dashboard to mux.
+func (a *apiServer) register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/snapshot", a.handleSnapshot)
+	mux.HandleFunc("GET /api/v1/schema", handleSchema)
+	mux.HandleFunc("GET /api/v1/namespaces", a.handleNamespaces)
+	mux.HandleFunc("GET /api/v1/pods", a.handlePods)
+	mux.HandleFunc("GET /api/v1/deployments", a.handleDeployments)
+
+	web, err := fs.Sub(webAssets, "web")
+	if err != nil {
+		// The embedded directory is fixed at build time.
+		panic(err)
+	}
+	mux.Handle("/", http.FileServer(http.FS(web)))
+}
+
+// handleSnapshot serves the glance/v1 Snapshot document.
+func (a *apiServer) handleSnapshot(w http.ResponseWriter, r *http.Request) {
+	snapshot, err := a.snapshot(r.Context())
+	writeAPIResponse(w, snapshot, err)
+}
+
+// handleSchema serves the JSON Schema of the snapshot document.
+func handleSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/schema+json")
+	_, _ = w.Write(core.SnapshotSchema)
+}
+
+// handleNamespaces serves per-namespace requests, limits and usage.
+func (a *apiServer) handleNamespaces(w http.ResponseWriter, r *http.Request) {
+	rows, err := CollectNamespaceStats(r.Context(), a.client, a.metricsSource, "")
+	writeAPIResponse(w, rows, err)
+}
+
+// handlePods serves pod rows, optionally limited by ?namespace=.
+func (a *apiServer) handlePods(w http.ResponseWriter, r *http.Request) {
+	rows, err := CollectPodStats(r.Context(), a.client, a.metricsSource,
+		r.URL.Query().Get("namespace"), labels.Everything())
+	if err == nil {
+		err = requirePodMetrics(a.metricsMode, a.metricsSource, rows)
+	}
+	writeAPIResponse(w, rows, err)
+}
+
+// handleDeployments serves deployment rows, optionally limited by
+// ?namespace=.
+func (a *apiServer) handleDeployments(w http.ResponseWriter, r *http.Request) {
+	rows, err := CollectDeploymentStats(r.Context(), a.client,
+		r.URL.Query().Get("namespace"), labels.Everything())
+	writeAPIResponse(w, rows, err)
+}
+
+// writeAPIResponse writes v as indented JSON, or err as a JSON error body
+// with status 500.
+func writeAPIResponse(w http.ResponseWriter, v any, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		log.Errorf("API request failed: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		v = map[string]string{"error": err.Error()}
+	}
+	data, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		log.Errorf("Error marshaling API response: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(append(data, '\n'))
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newAPITestServer(t *testing.T, snapshot func(ctx context.Context) (*core.SnapshotDocument, error)) *httptest.Server {
+	t.Helper()
+	pod := func(ns, name string, cpu int64) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name: "app",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: *resource.NewMilliQuantity(cpu, resource.DecimalSI)},
+				},
+			}}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"}}
+	client := fake.NewSimpleClientset(pod("shop", "web-1", 100), pod("shop", "web-2", 200), pod("ops", "agent", 50), deploy)
+
+	api := &apiServer{client: client, metricsMode: metricsModeAuto, snapshot: snapshot}
+	srv := httptest.NewServer(newServeMux(prometheus.NewRegistry(), api))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func getAPI(t *testing.T, srv *httptest.Server, path string, v any) int {
+	t.Helper()
+	resp, err := srv.Client().Get(srv.URL + path)
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", path, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if v != nil {
+		if err := json.Unmarshal(body, v); err != nil {
+			t.Fatalf("GET %s returned invalid JSON: %v\n%s", path, err, body)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestAPI_NamespacesPodsDeployments(t *testing.T) {
+	srv := newAPITestServer(t, nil)
+
+	var namespaces []NamespaceSummaryRow
+	if code := getAPI(t, srv, "/api/v1/namespaces", &namespaces); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if len(namespaces) != 2 || namespaces[0].Namespace != "ops" || namespaces[1].Namespace != "shop" {
+		t.Fatalf("unexpected namespaces: %+v", namespaces)
+	}
+	if namespaces[1].Pods != 2 || namespaces[1].CPUReq.MilliValue() != 300 {
+		t.Errorf("expected shop to have 2 pods requesting 300m, got %d pods and %s",
+			namespaces[1].Pods, namespaces[1].CPUReq.String())
+	}
+
+	var pods []PodSummaryRow
+	getAPI(t, srv, "/api/v1/pods?namespace=shop", &pods)
+	if len(pods) != 2 {
+		t.Errorf("expected 2 pods in shop, got %d", len(pods))
+	}
+	getAPI(t, srv, "/api/v1/pods", &pods)
+	if len(pods) != 3 {
+		t.Errorf("expected 3 pods across namespaces, got %d", len(pods))
+	}
+
+	var deployments []DeploymentSummaryRow
+	getAPI(t, srv, "/api/v1/deployments", &deployments)
+	if len(deployments) != 1 || deployments[0].Name != "web" {
+		t.Errorf("unexpected deployments: %+v", deployments)
+	}
+}
+
+func TestAPI_Snapshot(t *testing.T) {
+	cpu := resource.MustParse("4")
+	srv := newAPITestServer(t, func(context.Context) (*core.SnapshotDocument, error) {
+		s := core.NewSnapshot(core.NodeMap{"node-1": {Status: "Ready"}}, core.Totals{TotalAllocatableCPU: &cpu})
+		doc := core.NewSnapshotDocument(s, core.DocumentMetadata{Context: "test"})
+		return &doc, nil
+	})
+
+	var doc core.SnapshotDocument
+	if code := getAPI(t, srv, "/api/v1/snapshot", &doc); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if doc.APIVersion != core.DocumentAPIVersion || doc.Kind != core.KindSnapshot || doc.Metadata.Context != "test" {
+		t.Errorf("unexpected document header: %+v", doc)
+	}
+	if len(doc.Nodes) != 1 || doc.Nodes[0].Name != "node-1" || doc.Totals.CPU.Allocatable.Cores != 4 {
+		t.Errorf("unexpected snapshot: %+v", doc)
+	}
+
+	var schema map[string]any
+	if code := getAPI(t, srv, "/api/v1/schema", &schema); code != http.StatusOK || schema["$schema"] == nil {
+		t.Errorf("expected JSON Schema, got %d: %v", code, schema)
+	}
+}
+
+func TestAPI_Error(t *testing.T) {
+	srv := newAPITestServer(t, func(context.Context) (*core.SnapshotDocument, error) {
+		return nil, errors.New("no nodes found")
+	})
+
+	var body map[string]string
+	if code := getAPI(t, srv, "/api/v1/snapshot", &body); code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", code)
+	}
+	if body["error"] != "no nodes found" {
+		t.Errorf("expected error message in body, got %v", body)
+	}
+}
+
+func TestAPI_WebDashboard(t *testing.T) {
+	srv := newAPITestServer(t, nil)
+
+	resp, err := srv.Client().Get(srv.URL + "/")
+	if err != nil {
+		t.Fatalf("GET / failed: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "api/v1/snapshot") {
+		t.Errorf("expected embedded dashboard, got %d:\n%.200s", resp.StatusCode, body)
+	}
+	// /metrics must still be served next to the dashboard.
+	if code := getAPI(t, srv, "/metrics", nil); code != http.StatusOK {
+		t.Errorf("expected /metrics to be served, got %d", code)
+	}
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"golang.org/x/term"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	outputFormatChart = "chart"
+
+	// chartMaxNameWidth truncates long node and namespace names.
+	chartMaxNameWidth = 32
+	// chartMinBarWidth keeps bars readable on narrow terminals.
+	chartMinBarWidth = 10
+	// chartMaxNamespaces limits the namespace breakdown; the remaining
+	// namespaces are summed into one row.
+	chartMaxNamespaces = 15
+)
+
+// Bar glyphs, distinct so that the chart reads without colors.
+const (
+	chartGlyphUsage    = "█"
+	chartGlyphRequests = "▓"
+	chartGlyphLimits   = "▒"
+	chartGlyphFree     = "░"
+	chartGlyphOver     = "»" // limits beyond allocatable
+)
+
+// chartItem is one bar: the figures of a node or namespace for one resource,
+// in cores or bytes.
+type chartItem struct {
+	name        string
+	allocatable float64
+	requests    float64
+	limits      float64
+	usage       *float64 // nil when usage is unknown
+	total       string   // last column: allocatable of a node, pods of a namespace
+}
+
+// chartSection is a titled group of bars sharing the same figures.
+type chartSection struct {
+	title       string
+	totalHeader string // header of the item's total column
+	items       []chartItem
+}
+
+// chart prints static stacked bars of CPU and memory per node and a
+// breakdown by namespace. Colors are only used when stdout is a terminal.
+func chart(nm *core.NodeMap, c *core.Totals, namespaces *namespaceBreakdown) error {
+	color := term.IsTerminal(int(os.Stdout.Fd()))
+	writeChart(os.Stdout, chartSections(nm, c, namespaces), getTerminalWidth(), color)
+	return nil
+}
+
+// chartSections builds the node and namespace sections. Namespace bars are
+// relative to the allocatable capacity of the whole cluster.
+func chartSections(nm *core.NodeMap, c *core.Totals, namespaces *namespaceBreakdown) []chartSection {
+	metrics := c.MetricsAvailable
+
+	names := make([]string, 0, len(*nm))
+	for name := range *nm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	nodeCPU := chartSection{title: "CPU by node", totalHeader: "ALLOC"}
+	nodeMem := chartSection{title: "Memory by node", totalHeader: "ALLOC"}
+	for _, name := range names {
+		v := (*nm)[name]
+		label := name
+		if v.Status != nodeStatusReady {
+			label += " (" + v.Status + ")"
+		}
+		nodeCPU.items = append(nodeCPU.items, chartItem{
+			name:        label,
+			allocatable: cpuCores(v.AllocatableCPU),
+			requests:    cpuCores(&v.AllocatedCPUrequests),
+			limits:      cpuCores(&v.AllocatedCPULimits),
+			usage:       chartUsage(v.UsageCPU, metrics, cpuCores),
+			total:       formatMilliCPU(v.AllocatableCPU),
+		})
+		nodeMem.items = append(nodeMem.items, chartItem{
+			name:        label,
+			allocatable: quantityBytes(v.AllocatableMemory),
+			requests:    quantityBytes(&v.AllocatedMemoryRequests),
+			limits:      quantityBytes(&v.AllocatedMemoryLimits),
+			usage:       chartUsage(v.UsageMemory, metrics, quantityBytes),
+			total:       formatBytes(v.AllocatableMemory),
+		})
+	}
+	sections := []chartSection{nodeCPU, nodeMem}
+	if namespaces == nil || len(namespaces.groups) == 0 {
+		return sections
+	}
+
+	clusterCPU, clusterMem := cpuCores(c.TotalAllocatableCPU), quantityBytes(c.TotalAllocatableMemory)
+	nsCPU := chartSection{
+		title:       "CPU by namespace (share of cluster allocatable " + formatMilliCPU(c.TotalAllocatableCPU) + ")",
+		totalHeader: "PODS",
+	}
+	nsMem := chartSection{
+		title:       "Memory by namespace (share of cluster allocatable " + formatBytes(c.TotalAllocatableMemory) + ")",
+		totalHeader: "PODS",
+	}
+	for _, g := range chartNamespaceGroups(namespaces.groups) {
+		nsCPU.items = append(nsCPU.items, chartItem{
+			name:        g.name,
+			allocatable: clusterCPU,
+			requests:    cpuCores(&g.agg.CPURequests),
+			limits:      cpuCores(&g.agg.CPULimits),
+			usage:       chartUsage(&g.agg.CPUUsage, namespaces.usageKnown, cpuCores),
+			total:       fmt.Sprint(g.agg.Pods),
+		})
+		nsMem.items = append(nsMem.items, chartItem{
+			name:        g.name,
+			allocatable: clusterMem,
+			requests:    quantityBytes(&g.agg.MemoryRequests),
+			limits:      quantityBytes(&g.agg.MemoryLimits),
+			usage:       chartUsage(&g.agg.MemoryUsage, namespaces.usageKnown, quantityBytes),
+			total:       fmt.Sprint(g.agg.Pods),
+		})
+	}
+	return append(sections, nsCPU, nsMem)
+}
+
+// chartUsage converts a usage quantity, or returns nil when usage is unknown.
+func chartUsage(q *resource.Quantity, available bool, convert func(*resource.Quantity) float64) *float64 {
+	if !available || q == nil {
+		return nil
+	}
+	v := convert(q)
+	return &v
+}
+
+type chartNamespaceGroup struct {
+	name string
+	agg  *core.ResourceAggregate
+}
+
+// chartNamespaceGroups orders namespaces by CPU then memory requests and sums
+// those beyond chartMaxNamespaces into an "(N others)" group.
+func chartNamespaceGroups(namespaces map[string]*core.ResourceAggregate) []chartNamespaceGroup {
+	groups := make([]chartNamespaceGroup, 0, len(namespaces))
+	for name, agg := range namespaces {
+		groups = append(groups, chartNamespaceGroup{name: name, agg: agg})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := groups[i].agg, groups[j].agg
+		if c := a.CPURequests.Cmp(b.CPURequests); c != 0 {
+			return c > 0
+		}
+		if c := a.MemoryRequests.Cmp(b.MemoryRequests); c != 0 {
+			return c > 0
+		}
+		return groups[i].name < groups[j].name
+	})
+	if len(groups) <= chartMaxNamespaces {
+		return groups
+	}
+
+	rest := groups[chartMaxNamespaces-1:]
+	other := &core.ResourceAggregate{}
+	for _, g := range rest {
+		other.Pods += g.agg.Pods
+		other.CPURequests.Add(g.agg.CPURequests)
+		other.CPULimits.Add(g.agg.CPULimits)
+		other.CPUUsage.Add(g.agg.CPUUsage)
+		other.MemoryRequests.Add(g.agg.MemoryRequests)
+		other.MemoryLimits.Add(g.agg.MemoryLimits)
+		other.MemoryUsage.Add(g.agg.MemoryUsage)
+	}
+	return append(groups[:chartMaxNamespaces-1],
+		chartNamespaceGroup{name: fmt.Sprintf("(%d others)", len(rest)), agg: other})
+}
+
+// writeChart renders the sections to w, fitting bars into width columns.
+func writeChart(w io.Writer, sections []chartSection, width int, color bool) {
+	nameWidth := 4
+	for _, s := range sections {
+		for _, it := range s.items {
+			nameWidth = max(nameWidth, text.RuneWidthWithoutEscSequences(it.name))
+		}
+	}
+	nameWidth = min(nameWidth, chartMaxNameWidth)
+
+	// "NAME  BAR REQ LIM USE TOTAL", leaving the last terminal column free
+	// so that lines do not wrap.
+	const statsWidth = 4 + 5*3 + 9
+	barWidth := max(width-nameWidth-2-statsWidth-1, chartMinBarWidth)
+
+	paint := func(s string, colors ...text.Color) string {
+		if !color || s == "" {
+			return s
+		}
+		return text.Colors(colors).Sprint(s)
+	}
+
+	_, _ = fmt.Fprintf(w, "%s usage  %s requests  %s limits  %s allocatable  %s limits over allocatable\n",
+		paint(chartGlyphUsage, text.FgGreen), paint(chartGlyphRequests, text.FgCyan),
+		paint(chartGlyphLimits, text.FgBlue), paint(chartGlyphFree, text.FgHiBlack), chartGlyphOver)
+
+	for _, s := range sections {
+		_, _ = fmt.Fprintf(w, "\n%s\n", paint(s.title, text.Bold))
+		_, _ = fmt.Fprintf(w, "%-*s  %-*s %5s %5s %5s %9s\n",
+			nameWidth, "NAME", barWidth, "", "REQ", "LIM", "USE", s.totalHeader)
+		for _, it := range s.items {
+			name := text.Trim(it.name, nameWidth)
+			use := usageNotAvailable
+			if it.usage != nil {
+				use = chartPercent(*it.usage, it.allocatable)
+			}
+			_, _ = fmt.Fprintf(w, "%-*s  %s %5s %5s %5s %9s\n",
+				nameWidth, name, chartBar(it, barWidth, paint),
+				chartPercent(it.requests, it.allocatable), chartPercent(it.limits, it.allocatable), use, it.total)
+		}
+	}
+}
+
+// chartBar draws one stacked bar of width cells, where the full width is
+// the item's allocatable. Each cell shows the highest band it falls in:
+// usage, then requests, then limits, then free allocatable. Usage above
+// requests therefore still shows as usage.
+func chartBar(it chartItem, width int, paint func(string, ...text.Color) string) string {
+	if it.allocatable <= 0 {
+		return strings.Repeat(" ", width)
+	}
+	cells := func(v float64) int {
+		n := int(v/it.allocatable*float64(width) + 0.5)
+		return max(0, min(n, width))
+	}
+	use := 0
+	useColor := text.FgGreen
+	if it.usage != nil {
+		use = cells(*it.usage)
+		switch pct := *it.usage / it.allocatable * 100; {
+		case pct >= thresholdHigh:
+			useColor = text.FgRed
+		case pct >= thresholdMedium:
+			useColor = text.FgYellow
+		}
+	}
+	req := max(cells(it.requests), use)
+	lim := max(cells(it.limits), req)
+
+	var b strings.Builder
+	b.WriteString(paint(strings.Repeat(chartGlyphUsage, use), useColor))
+	b.WriteString(paint(strings.Repeat(chartGlyphRequests, req-use), text.FgCyan))
+	free := width - lim
+	if it.limits > it.allocatable && lim-req > 0 {
+		// Mark overcommitted limits in the last cell.
+		b.WriteString(paint(strings.Repeat(chartGlyphLimits, lim-req-1)+chartGlyphOver, text.FgBlue))
+	} else {
+		b.WriteString(paint(strings.Repeat(chartGlyphLimits, lim-req), text.FgBlue))
+	}
+	b.WriteString(paint(strings.Repeat(chartGlyphFree, free), text.FgHiBlack))
+	return b.String()
+}
+
+// chartPercent formats v as a whole percentage of total.
+func chartPercent(v, total float64) string {
+	if total <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", percentOf(v, total))
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func newChartTestSnapshot(metrics bool) (NodeMap, *Totals, *namespaceBreakdown) {
+	nm, totals := buildTestSnapshot(metrics,
+		testNode{name: "node-1", status: "Ready", cpu: "4", memory: "8Gi",
+			cpuReq: "2", cpuLim: "6", memReq: "2Gi", memLim: "4Gi", cpuUsage: "1", memUsage: "1Gi"},
+		testNode{name: "node-2", status: "Not Ready"},
+	)
+	groups := map[string]*core.ResourceAggregate{
+		"payments": {Pods: 3, CPURequests: resource.MustParse("1500m"), CPULimits: resource.MustParse("3"),
+			CPUUsage: resource.MustParse("800m"), MemoryRequests: resource.MustParse("1Gi")},
+		"kube-system": {Pods: 5, CPURequests: resource.MustParse("500m")},
+	}
+	return *nm, totals, &namespaceBreakdown{groups: groups, usageKnown: metrics}
+}
+
+func TestWriteChart(t *testing.T) {
+	nm, totals, namespaces := newChartTestSnapshot(true)
+
+	var buf bytes.Buffer
+	writeChart(&buf, chartSections(&nm, totals, namespaces), 80, false)
+	out := buf.String()
+
+	if strings.Contains(out, "\x1b[") {
+		t.Error("expected no ANSI escapes without color")
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if w := text.RuneWidthWithoutEscSequences(line); w > 80 {
+			t.Errorf("line wider than the terminal (%d): %q", w, line)
+		}
+	}
+	for _, want := range []string{"CPU by node", "Memory by node", "CPU by namespace", "node-2 (Not Ready)", "PODS"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+
+	// node-1 CPU: usage 25%, requests 50%, limits 150% of allocatable.
+	var cpuLine string
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "node-1") {
+			cpuLine = line
+			break
+		}
+	}
+	if !strings.Contains(cpuLine, "50%  150%   25%") {
+		t.Errorf("unexpected node-1 CPU figures: %q", cpuLine)
+	}
+	if strings.Count(cpuLine, chartGlyphUsage) == 0 || !strings.Contains(cpuLine, chartGlyphOver) ||
+		strings.Contains(cpuLine, chartGlyphFree) {
+		t.Errorf("expected usage, requests and overcommitted limits filling the bar: %q", cpuLine)
+	}
+
+	// Namespaces are ordered by requests.
+	if strings.Index(out, "payments") > strings.Index(out, "kube-system") {
+		t.Errorf("expected payments before kube-system:\n%s", out)
+	}
+}
+
+func TestWriteChart_NoMetrics(t *testing.T) {
+	nm, totals, namespaces := newChartTestSnapshot(false)
+
+	var buf bytes.Buffer
+	writeChart(&buf, chartSections(&nm, totals, namespaces), 100, false)
+	out := buf.String()
+	if strings.Count(out, usageNotAvailable) != 8 {
+		t.Errorf("expected usage marked %s for every bar:\n%s", usageNotAvailable, out)
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "node-1") && strings.Contains(line, chartGlyphUsage) {
+			t.Errorf("expected no usage segment without metrics: %q", line)
+		}
+	}
+}
+
+func TestChartNamespaceGroups(t *testing.T) {
+	groups := make(map[string]*core.ResourceAggregate)
+	for i := 0; i < chartMaxNamespaces+5; i++ {
+		groups[fmt.Sprintf("ns-%02d", i)] = &core.ResourceAggregate{Pods: 1, CPURequests: *resource.NewMilliQuantity(int64(100*(i+1)), resource.DecimalSI)}
+	}
+	got := chartNamespaceGroups(groups)
+	if len(got) != chartMaxNamespaces {
+		t.Fatalf("expected %d groups, got %d", chartMaxNamespaces, len(got))
+	}
+	if got[0].name != "ns-19" {
+		t.Errorf("expected the largest namespace first, got %s", got[0].name)
+	}
+	last := got[len(got)-1]
+	if last.name != "(6 others)" || last.agg.Pods != 6 || last.agg.CPURequests.MilliValue() != 100+200+300+400+500+600 {
+		t.Errorf("unexpected remainder group %s %+v", last.name, last.agg)
+	}
+}
@@ -0,0 +1,953 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	pt "github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// outputFormatJUnit prints "glance check" results as JUnit XML.
+const outputFormatJUnit = "junit"
+
+// Rule outcomes of "glance check", in increasing severity.
+const (
+	checkPass = "pass"
+	checkWarn = "warn"
+	checkFail = "fail"
+)
+
+// Exit codes of "glance check". Errors (an invalid policy, an unreachable
+// cluster) use their own code so pipelines can tell them from a failed rule.
+const (
+	checkExitPass  = 0
+	checkExitWarn  = 1
+	checkExitFail  = 2
+	checkExitError = 3
+)
+
+// Scopes a check rule is evaluated over.
+const (
+	checkScopeCluster    = "cluster"
+	checkScopeNode       = "node"
+	checkScopeNamespace  = "namespace"
+	checkScopeDeployment = "deployment"
+)
+
+// checkMetrics lists the metrics of each scope. Percentages are relative to
+// allocatable (or to the quota's hard limit for quotaPercent).
+var checkMetrics = map[string][]string{
+	checkScopeCluster: {
+		"cpu.requestsPercent", "cpu.limitsPercent", "cpu.usagePercent",
+		"memory.requestsPercent", "memory.limitsPercent", "memory.usagePercent",
+		"pendingPods", "oomKills", "notReadyNodes",
+	},
+	checkScopeNode: {
+		"cpu.requestsPercent", "cpu.limitsPercent", "cpu.usagePercent",
+		"memory.requestsPercent", "memory.limitsPercent", "memory.usagePercent",
+		"oomKills", "notReady",
+	},
+	checkScopeNamespace:  {"quotaPercent"},
+	checkScopeDeployment: {"spotOnly", "unavailableReplicas"},
+}
+
+// booleanCheckMetrics are true/false metrics. A rule on one reports its
+// severity for every subject where the metric is true instead of comparing
+// against thresholds.
+var booleanCheckMetrics = map[string]bool{
+	"notReady": true,
+	"spotOnly": true,
+}
+
+// checkMaxListed bounds the subjects named in a rule's message.
+const checkMaxListed = 5
+
+// ExitError makes the command exit with Code. Err, when set, is printed to
+// stderr first; a nil Err exits quietly after the command's own output.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit status %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error { return e.Err }
+
+// checkPolicy is the policy file read by "glance check --policy".
+type checkPolicy struct {
+	Rules []checkRule `json:"rules"`
+}
+
+// checkRule is one rule of a policy. A subject whose metric is greater than
+// Fail fails the rule, and one greater than Warn warns. Boolean metrics use
+// Severity (default fail) instead of thresholds.
+type checkRule struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Scope       string   `json:"scope"`
+	Metric      string   `json:"metric"`
+	Warn        *float64 `json:"warn,omitempty"`
+	Fail        *float64 `json:"fail,omitempty"`
+	Severity    string   `json:"severity,omitempty"`
+	// Exclude skips subjects whose name matches one of the shell patterns,
+	// e.g. "kube-*". Deployments are named "<namespace>/<name>".
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// loadCheckPolicy reads and validates a policy file.
+func loadCheckPolicy(file string) (*checkPolicy, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy: %w", err)
+	}
+	policy, err := parseCheckPolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy %s: %w", file, err)
+	}
+	return policy, nil
+}
+
+// parseCheckPolicy parses and validates a YAML or JSON policy.
+func parseCheckPolicy(data []byte) (*checkPolicy, error) {
+	var policy checkPolicy
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return nil, err
+	}
+	if len(policy.Rules) == 0 {
+		return nil, fmt.Errorf("no rules defined")
+	}
+
+	names := make(map[string]bool, len(policy.Rules))
+	for i := range policy.Rules {
+		r := &policy.Rules[i]
+		if r.Name == "" {
+			return nil, fmt.Errorf("rule %d: name is required", i+1)
+		}
+		if names[r.Name] {
+			return nil, fmt.Errorf("rule %s: duplicate name", r.Name)
+		}
+		names[r.Name] = true
+
+		metrics, ok := checkMetrics[r.Scope]
+		if !ok {
+			return nil, fmt.Errorf("rule %s: unknown scope %q (one of cluster, node, namespace, deployment)", r.Name, r.Scope)
+		}
+		if !containsString(metrics, r.Metric) {
+			return nil, fmt.Errorf("rule %s: unknown %s metric %q (one of %s)",
+				r.Name, r.Scope, r.Metric, strings.Join(metrics, ", "))
+		}
+		for _, pattern := range r.Exclude {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("rule %s: invalid exclude pattern %q", r.Name, pattern)
+			}
+		}
+
+		if booleanCheckMetrics[r.Metric] {
+			if r.Warn != nil || r.Fail != nil {
+				return nil, fmt.Errorf("rule %s: %s is a boolean metric; use severity instead of warn/fail", r.Name, r.Metric)
+			}
+			switch r.Severity {
+			case "":
+				r.Severity = checkFail
+			case checkWarn, checkFail:
+			default:
+				return nil, fmt.Errorf("rule %s: severity must be warn or fail, got %q", r.Name, r.Severity)
+			}
+			continue
+		}
+		if r.Severity != "" {
+			return nil, fmt.Errorf("rule %s: severity only applies to boolean metrics; use warn/fail thresholds", r.Name)
+		}
+		if r.Warn == nil && r.Fail == nil {
+			return nil, fmt.Errorf("rule %s: warn or fail threshold is required", r.Name)
+		}
+		if r.Warn != nil && r.Fail != nil && *r.Warn > *r.Fail {
+			return nil, fmt.Errorf("rule %s: warn threshold %g is above fail threshold %g", r.Name, *r.Warn, *r.Fail)
+		}
+	}
+	return &policy, nil
+}
+
+// scopes returns the scopes used by the policy's rules.
+func (p *checkPolicy) scopes() map[string]bool {
+	scopes := make(map[string]bool)
+	for _, r := range p.Rules {
+		scopes[r.Scope] = true
+	}
+	return scopes
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSubject is one object a rule is evaluated against: the cluster, a
+// node, a namespace or a deployment. A nil value is unknown, e.g. usage
+// while metrics are unavailable.
+type checkSubject struct {
+	name   string
+	values map[string]*float64
+	// notes add context to a metric's value, e.g. the quota resource.
+	notes map[string]string
+}
+
+func newCheckSubject(name string) checkSubject {
+	return checkSubject{name: name, values: map[string]*float64{}, notes: map[string]string{}}
+}
+
+func (s checkSubject) set(metric string, v float64) {
+	s.values[metric] = &v
+}
+
+func (s checkSubject) setBool(metric string, v bool) {
+	if v {
+		s.set(metric, 1)
+	} else {
+		s.set(metric, 0)
+	}
+}
+
+// setFigures sets the cpu.* and memory.* percentages of a document figure.
+func (s checkSubject) setFigures(cpu core.CPUFigures, memory core.MemoryFigures) {
+	s.set("cpu.requestsPercent", cpu.RequestsPercent)
+	s.set("cpu.limitsPercent", cpu.LimitsPercent)
+	s.values["cpu.usagePercent"] = cpu.UsagePercent
+	s.set("memory.requestsPercent", memory.RequestsPercent)
+	s.set("memory.limitsPercent", memory.LimitsPercent)
+	s.values["memory.usagePercent"] = memory.UsagePercent
+}
+
+// checkInput holds the subjects of every scope used by a policy.
+type checkInput struct {
+	metadata core.DocumentMetadata
+	subjects map[string][]checkSubject
+}
+
+// documentCheckSubjects returns the cluster and node subjects of a snapshot
+// document.
+func documentCheckSubjects(doc core.SnapshotDocument) (cluster checkSubject, nodes []checkSubject) {
+	cluster = newCheckSubject(checkScopeCluster)
+	cluster.setFigures(doc.Totals.CPU, doc.Totals.Memory)
+	cluster.set("pendingPods", float64(doc.Totals.Pending.Pods))
+	cluster.set("oomKills", float64(doc.Totals.OOMKills))
+
+	notReady := 0
+	for _, n := range doc.Nodes {
+		s := newCheckSubject(n.Name)
+		s.setFigures(n.CPU, n.Memory)
+		s.set("oomKills", float64(n.OOMKills))
+		s.setBool("notReady", n.Status != nodeStatusReady)
+		if n.Status != nodeStatusReady {
+			notReady++
+		}
+		nodes = append(nodes, s)
+	}
+	cluster.set("notReadyNodes", float64(notReady))
+	return cluster, nodes
+}
+
+// collectCheckInput builds the subjects of the scopes in use. Cluster and
+// node subjects come from doc; namespace quotas and deployment placement
+// are listed from the API, limited to namespace when set.
+func collectCheckInput(ctx context.Context, k8sClient kubernetes.Interface,
+	doc core.SnapshotDocument, namespace string, scopes map[string]bool) (*checkInput, error) {
+	cluster, nodes := documentCheckSubjects(doc)
+	in := &checkInput{
+		metadata: doc.Metadata,
+		subjects: map[string][]checkSubject{
+			checkScopeCluster: {cluster},
+			checkScopeNode:    nodes,
+		},
+	}
+
+	if scopes[checkScopeNamespace] {
+		subjects, err := collectQuotaSubjects(ctx, k8sClient, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resource quotas: %w", err)
+		}
+		in.subjects[checkScopeNamespace] = subjects
+	}
+	if scopes[checkScopeDeployment] {
+		subjects, err := collectDeploymentSubjects(ctx, k8sClient, doc, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to collect deployments: %w", err)
+		}
+		in.subjects[checkScopeDeployment] = subjects
+	}
+	return in, nil
+}
+
+// collectQuotaSubjects returns one subject per namespace with a
+// ResourceQuota. quotaPercent is the highest used/hard ratio over all quotas
+// and resources of the namespace; namespaces without quotas are not listed.
+func collectQuotaSubjects(ctx context.Context, k8sClient kubernetes.Interface, namespace string) ([]checkSubject, error) {
+	quotas, err := k8sClient.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, err
+	}
+
+	byNamespace := make(map[string]checkSubject)
+	for _, q := range quotas.Items {
+		s, ok := byNamespace[q.Namespace]
+		if !ok {
+			s = newCheckSubject(q.Namespace)
+			byNamespace[q.Namespace] = s
+		}
+		for name, hard := range q.Status.Hard {
+			if hard.IsZero() {
+				continue
+			}
+			used := q.Status.Used[name]
+			// Rounded to two decimals, like the snapshot document's percentages.
+			pct := math.Round(used.AsApproximateFloat64()/hard.AsApproximateFloat64()*10000) / 100
+			if cur := s.values["quotaPercent"]; cur == nil || pct > *cur {
+				s.set("quotaPercent", pct)
+				s.notes["quotaPercent"] = fmt.Sprintf("%s %s: %s of %s", q.Name, name, used.String(), hard.String())
+			}
+		}
+	}
+
+	subjects := make([]checkSubject, 0, len(byNamespace))
+	for _, s := range byNamespace {
+		if _, ok := s.values["quotaPercent"]; !ok {
+			s.set("quotaPercent", 0)
+		}
+		subjects = append(subjects, s)
+	}
+	sort.Slice(subjects, func(i, j int) bool { return subjects[i].name < subjects[j].name })
+	return subjects, nil
+}
+
+// collectDeploymentSubjects returns one subject per deployment, named
+// "<namespace>/<name>". spotOnly is true when the deployment has running
+// pods and all of them are on spot nodes; capacity types come from the
+// well-known node labels, falling back to cloud metadata in doc.
+func collectDeploymentSubjects(ctx context.Context, k8sClient kubernetes.Interface,
+	doc core.SnapshotDocument, namespace string) ([]checkSubject, error) {
+	rows, err := CollectDeploymentStats(ctx, k8sClient, namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	capacityTypes := make(map[string]string, len(doc.Nodes))
+	for _, n := range doc.Nodes {
+		capacityTypes[n.Name] = strings.ToUpper(n.CapacityType)
+	}
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range nodes.Items {
+		if ct := extractCapacityTypeFromLabels(n.Labels); ct != "" {
+			capacityTypes[n.Name] = ct
+		}
+	}
+
+	pods, err := k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, err
+	}
+	type placement struct{ running, spot int }
+	placements := make(map[string]*placement)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != v1.PodRunning || pod.Spec.NodeName == "" {
+			continue
+		}
+		kind, name := core.WorkloadOf(pod)
+		if kind != core.WorkloadDeployment {
+			continue
+		}
+		key := pod.Namespace + "/" + name
+		p := placements[key]
+		if p == nil {
+			p = &placement{}
+			placements[key] = p
+		}
+		p.running++
+		if capacityTypes[pod.Spec.NodeName] == capacityTypeSpot {
+			p.spot++
+		}
+	}
+
+	subjects := make([]checkSubject, 0, len(rows))
+	for _, d := range rows {
+		s := newCheckSubject(d.Namespace + "/" + d.Name)
+		p := placements[s.name]
+		s.setBool("spotOnly", p != nil && p.running > 0 && p.spot == p.running)
+		if p != nil {
+			s.notes["spotOnly"] = fmt.Sprintf("%d/%d pods on spot nodes", p.spot, p.running)
+		}
+		unavailable := d.Replicas - d.Available
+		if unavailable < 0 {
+			unavailable = 0
+		}
+		s.set("unavailableReplicas", float64(unavailable))
+		subjects = append(subjects, s)
+	}
+	sort.Slice(subjects, func(i, j int) bool { return subjects[i].name < subjects[j].name })
+	return subjects, nil
+}
+
+// capacityTypeSpot is the capacity type of spot and preemptible nodes.
+const capacityTypeSpot = "SPOT"
+
+// checkViolation is a subject that warned or failed a rule.
+type checkViolation struct {
+	Subject string  `json:"subject"`
+	Status  string  `json:"status"`
+	Value   float64 `json:"value"`
+	Note    string  `json:"note,omitempty"`
+}
+
+// checkResult is the outcome of one rule.
+type checkResult struct {
+	Rule        string           `json:"rule"`
+	Description string           `json:"description,omitempty"`
+	Scope       string           `json:"scope"`
+	Metric      string           `json:"metric"`
+	Warn        *float64         `json:"warn,omitempty"`
+	Fail        *float64         `json:"fail,omitempty"`
+	Status      string           `json:"status"`
+	Message     string           `json:"message"`
+	Checked     int              `json:"checked"`
+	Unknown     []string         `json:"unknown,omitempty"`
+	Violations  []checkViolation `json:"violations"`
+}
+
+// checkReport is the JSON output of "glance check".
+type checkReport struct {
+	APIVersion string                `json:"apiVersion"`
+	Kind       string                `json:"kind"`
+	Metadata   core.DocumentMetadata `json:"metadata"`
+	Status     string                `json:"status"`
+	Results    []checkResult         `json:"results"`
+}
+
+// evaluateCheckPolicy evaluates every rule of policy against in. Subjects
+// with an unknown value make a rule warn at least, since it could not be
+// verified.
+func evaluateCheckPolicy(policy *checkPolicy, in *checkInput) []checkResult {
+	results := make([]checkResult, 0, len(policy.Rules))
+	for _, r := range policy.Rules {
+		res := checkResult{
+			Rule:        r.Name,
+			Description: r.Description,
+			Scope:       r.Scope,
+			Metric:      r.Metric,
+			Warn:        r.Warn,
+			Fail:        r.Fail,
+			Status:      checkPass,
+			Violations:  []checkViolation{},
+		}
+
+		var maxSubject string
+		var maxValue float64
+		for _, s := range in.subjects[r.Scope] {
+			if excluded(s.name, r.Exclude) {
+				continue
+			}
+			res.Checked++
+			v := s.values[r.Metric]
+			if v == nil {
+				res.Unknown = append(res.Unknown, s.name)
+				continue
+			}
+			if maxSubject == "" || *v > maxValue {
+				maxSubject, maxValue = s.name, *v
+			}
+			if status := r.statusOf(*v); status != checkPass {
+				res.Violations = append(res.Violations, checkViolation{
+					Subject: s.name, Status: status, Value: *v, Note: s.notes[r.Metric],
+				})
+			}
+		}
+
+		sort.SliceStable(res.Violations, func(i, j int) bool {
+			a, b := res.Violations[i], res.Violations[j]
+			if a.Status != b.Status {
+				return a.Status == checkFail
+			}
+			return a.Value > b.Value
+		})
+		for _, v := range res.Violations {
+			res.Status = worseCheckStatus(res.Status, v.Status)
+		}
+		if len(res.Unknown) > 0 {
+			res.Status = worseCheckStatus(res.Status, checkWarn)
+		}
+		res.Message = r.message(res, maxSubject, maxValue, in.metadata.MetricsAvailable)
+		results = append(results, res)
+	}
+	return results
+}
+
+// statusOf returns the outcome of a single value.
+func (r checkRule) statusOf(v float64) string {
+	if booleanCheckMetrics[r.Metric] {
+		if v != 0 {
+			return r.Severity
+		}
+		return checkPass
+	}
+	if r.Fail != nil && v > *r.Fail {
+		return checkFail
+	}
+	if r.Warn != nil && v > *r.Warn {
+		return checkWarn
+	}
+	return checkPass
+}
+
+// message summarizes a rule's result in one line.
+func (r checkRule) message(res checkResult, maxSubject string, maxValue float64, metricsAvailable bool) string {
+	var parts []string
+	switch {
+	case res.Checked == 0:
+		parts = append(parts, fmt.Sprintf("no %s to check", pluralScope(r.Scope)))
+	case len(res.Violations) > 0:
+		listed := make([]string, 0, checkMaxListed)
+		for i, v := range res.Violations {
+			if i == checkMaxListed {
+				listed = append(listed, fmt.Sprintf("+%d more", len(res.Violations)-checkMaxListed))
+				break
+			}
+			entry := v.Subject
+			if !booleanCheckMetrics[r.Metric] {
+				entry += " (" + formatCheckValue(r.Metric, v.Value) + ")"
+			} else if v.Note != "" {
+				entry += " (" + v.Note + ")"
+			}
+			listed = append(listed, entry)
+		}
+		what := r.Metric
+		if !booleanCheckMetrics[r.Metric] {
+			what += " above " + formatCheckValue(r.Metric, r.lowestThreshold())
+		}
+		if r.Scope == checkScopeCluster {
+			parts = append(parts, fmt.Sprintf("%s: %s", what, strings.Join(listed, ", ")))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d of %d %s with %s: %s",
+				len(res.Violations), res.Checked, pluralScope(r.Scope), what, strings.Join(listed, ", ")))
+		}
+	case booleanCheckMetrics[r.Metric]:
+		parts = append(parts, fmt.Sprintf("none of %d %s", res.Checked, pluralScope(r.Scope)))
+	case maxSubject != "":
+		if r.Scope == checkScopeCluster {
+			parts = append(parts, formatCheckValue(r.Metric, maxValue))
+		} else {
+			parts = append(parts, fmt.Sprintf("max %s (%s)", formatCheckValue(r.Metric, maxValue), maxSubject))
+		}
+	}
+	if len(res.Unknown) > 0 {
+		reason := "no data"
+		if strings.HasSuffix(r.Metric, "usagePercent") && !metricsAvailable {
+			reason = "usage metrics unavailable"
+		}
+		if r.Scope == checkScopeCluster {
+			parts = append(parts, reason)
+		} else {
+			parts = append(parts, fmt.Sprintf("%s for %d %s", reason, len(res.Unknown), pluralScope(r.Scope)))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// lowestThreshold returns the warn threshold, or fail when warn is unset.
+func (r checkRule) lowestThreshold() float64 {
+	if r.Warn != nil {
+		return *r.Warn
+	}
+	return *r.Fail
+}
+
+func excluded(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func pluralScope(scope string) string {
+	switch scope {
+	case checkScopeCluster:
+		return "cluster"
+	case checkScopeNamespace:
+		return "namespaces with quotas"
+	default:
+		return scope + "s"
+	}
+}
+
+// formatCheckValue prints percentages with one decimal and counts plainly.
+func formatCheckValue(metric string, v float64) string {
+	if strings.HasSuffix(metric, "Percent") {
+		return fmt.Sprintf("%.1f%%", v)
+	}
+	return fmt.Sprintf("%g", v)
+}
+
+// worseCheckStatus returns the more severe of two outcomes.
+func worseCheckStatus(a, b string) string {
+	rank := map[string]int{checkPass: 0, checkWarn: 1, checkFail: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// overallCheckStatus returns the most severe outcome of results.
+func overallCheckStatus(results []checkResult) string {
+	status := checkPass
+	for _, r := range results {
+		status = worseCheckStatus(status, r.Status)
+	}
+	return status
+}
+
+// checkExitCode maps an overall outcome to the process exit code.
+func checkExitCode(status string) int {
+	switch status {
+	case checkFail:
+		return checkExitFail
+	case checkWarn:
+		return checkExitWarn
+	default:
+		return checkExitPass
+	}
+}
+
+// renderCheckResults prints results in the global output format: a JSON
+// report, JUnit XML or, for any other format, a table.
+func renderCheckResults(results []checkResult, meta core.DocumentMetadata) error {
+	output := viper.GetString("output")
+	if isTemplateFormat(output) {
+		return renderTemplateOutput(output, checkRows(results))
+	}
+	report := checkReport{
+		APIVersion: core.DocumentAPIVersion,
+		Kind:       "CheckReport",
+		Metadata:   meta,
+		Status:     overallCheckStatus(results),
+		Results:    results,
+	}
+	switch output {
+	case outputFormatJSON:
+		b, err := json.MarshalIndent(report, "", "\t")
+		if err != nil {
+			log.Errorf("failed to marshal check results to JSON: %v", err)
+			return fmt.Errorf("failed to render check JSON output: %w", err)
+		}
+		fmt.Println(string(b))
+		return nil
+	case outputFormatYAML:
+		return renderYAML(report, "check results")
+	case outputFormatJUnit:
+		return writeCheckJUnit(os.Stdout, results, meta)
+	case outputFormatCSV, outputFormatMarkdown, outputFormatHTML, outputFormatChart, outputFormatDash, outputFormatPie:
+		return fmt.Errorf("output format %q is not supported by check; use -o json, -o yaml, -o junit or a template format", output)
+	default:
+		renderCheckTable(results, output == outputFormatPretty)
+		return nil
+	}
+}
+
+// renderCheckTable prints one row per rule and a summary line.
+func renderCheckTable(results []checkResult, pretty bool) {
+	t := pt.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	if pretty {
+		t.SetStyle(pt.StyleRounded)
+	} else {
+		t.SetStyle(pt.StyleLight)
+	}
+	t.AppendHeader(pt.Row{"RULE", "SCOPE", "STATUS", "RESULT"})
+	t.SetColumnConfigs([]pt.ColumnConfig{{Number: 4, WidthMax: 100}})
+
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Status]++
+		status := strings.ToUpper(r.Status)
+		if pretty {
+			status = checkStatusColor(r.Status).Sprint(status)
+		}
+		t.AppendRow(pt.Row{r.Rule, r.Scope + " " + r.Metric, status, r.Message})
+	}
+	t.Render()
+	fmt.Printf("%d passed, %d warnings, %d failed\n", counts[checkPass], counts[checkWarn], counts[checkFail])
+}
+
+func checkStatusColor(status string) text.Colors {
+	switch status {
+	case checkFail:
+		return text.Colors{text.FgRed, text.Bold}
+	case checkWarn:
+		return text.Colors{text.FgYellow}
+	default:
+		return text.Colors{text.FgGreen}
+	}
+}
+
+// JUnit XML as understood by GitLab, Jenkins and GitHub test reporters.
+// Each rule is a test case; failed rules carry a <failure> and warnings are
+// reported in <system-out> so they do not fail the pipeline on their own.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeCheckJUnitFile writes the JUnit XML report to path. A failed close
+// is reported like a failed write, since the report may be incomplete.
+func writeCheckJUnitFile(path string, results []checkResult, meta core.DocumentMetadata) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create JUnit file: %w", err)
+	}
+	if err := writeCheckJUnit(f, results, meta); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write JUnit file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write JUnit file: %w", err)
+	}
+	return nil
+}
+
+// writeCheckJUnit writes results as a JUnit XML report with one test suite
+// named after the cluster.
+func writeCheckJUnit(w io.Writer, results []checkResult, meta core.DocumentMetadata) error {
+	suiteName := "glance check"
+	if meta.Context != "" {
+		suiteName += " " + meta.Context
+	}
+	suite := junitTestSuite{Name: suiteName, Tests: len(results)}
+	if !meta.GeneratedAt.IsZero() {
+		suite.Timestamp = meta.GeneratedAt.UTC().Format(time.RFC3339)
+	}
+
+	for _, r := range results {
+		tc := junitTestCase{Name: r.Rule, Classname: "glance." + r.Scope}
+		details := r.Message
+		for _, v := range r.Violations {
+			line := fmt.Sprintf("%s %s: %s", strings.ToUpper(v.Status), v.Subject, formatCheckValue(r.Metric, v.Value))
+			if v.Note != "" {
+				line += " (" + v.Note + ")"
+			}
+			details += "\n" + line
+		}
+		switch r.Status {
+		case checkFail:
+			suite.Failures++
+			tc.Failure = &junitFailure{Message: r.Message, Type: checkFail, Text: details}
+		case checkWarn:
+			tc.SystemOut = "WARN: " + details
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	doc := junitTestSuites{Name: "glance check", Tests: suite.Tests, Failures: suite.Failures, Suites: []junitTestSuite{suite}}
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to render JUnit XML: %w", err)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
+
+// NewCheckCmd creates the "glance check" subcommand.
+func NewCheckCmd(gc *GlanceConfig) *cobra.Command {
+	var policyFile, junitFile string
+
+	cmd := &cobra.Command{
+		Use:   "check --policy FILE",
+		Short: "Evaluate capacity rules against the cluster for CI gates and audits",
+		Long: `Evaluate the rules of a policy file against the cluster and print pass, warn
+or fail for each rule. Rules compare a metric of the cluster, every node,
+every namespace with a ResourceQuota or every deployment against warn and
+fail thresholds:
+
+  rules:
+    - name: cluster-cpu-requests
+      scope: cluster
+      metric: cpu.requestsPercent
+      warn: 75
+      fail: 85
+    - name: node-memory-usage
+      scope: node
+      metric: memory.usagePercent
+      fail: 90
+    - name: namespace-quota
+      scope: namespace
+      metric: quotaPercent
+      fail: 95
+      exclude: ["kube-*"]
+    - name: no-spot-only-deployments
+      scope: deployment
+      metric: spotOnly
+      severity: fail
+
+Metrics:
+  cluster     cpu|memory.requestsPercent, .limitsPercent, .usagePercent,
+              pendingPods, oomKills, notReadyNodes
+  node        cpu|memory.requestsPercent, .limitsPercent, .usagePercent,
+              oomKills, notReady (boolean)
+  namespace   quotaPercent (highest used/hard of the namespace's quotas)
+  deployment  spotOnly (boolean), unavailableReplicas
+
+A value above fail fails the rule and a value above warn warns. Rules that
+cannot be verified, such as usage rules without metrics, warn.
+
+Exit status is 0 when every rule passes, 1 when a rule warns, 2 when a rule
+fails and 3 on errors. Respects --namespace/-n for namespace and deployment
+rules and --output (txt, pretty, json, junit).`,
+		Example: `  kubectl glance check --policy policy.yaml
+  kubectl glance check --policy policy.yaml --junit-file glance-check.xml
+  kubectl glance check --policy policy.yaml -o junit > report.xml`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.NoArgs(cmd, args); err != nil {
+				return &ExitError{Code: checkExitError, Err: err}
+			}
+			return nil
+		},
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if policyFile == "" {
+				return &ExitError{Code: checkExitError, Err: fmt.Errorf("--policy is required")}
+			}
+			status, err := runCheck(gc, policyFile, junitFile)
+			if err != nil {
+				return &ExitError{Code: checkExitError, Err: err}
+			}
+			if code := checkExitCode(status); code != checkExitPass {
+				return &ExitError{Code: code}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&policyFile, "policy", "", "Policy file (YAML or JSON) with the rules to evaluate")
+	cmd.Flags().StringVar(&junitFile, "junit-file", "", "Also write the results as JUnit XML to this file")
+	// Usage errors must not exit 1, which means "a rule warned".
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return &ExitError{Code: checkExitError, Err: err}
+	})
+
+	return cmd
+}
+
+// runCheck collects the cluster, evaluates the policy and renders the
+// results, returning the overall outcome.
+func runCheck(gc *GlanceConfig, policyFile, junitFile string) (string, error) {
+	policy, err := loadCheckPolicy(policyFile)
+	if err != nil {
+		return "", err
+	}
+
+	rc, err := gc.configFlags.ToRESTConfig()
+	if err != nil {
+		return "", fmt.Errorf("failed to get kubernetes config: %w", err)
+	}
+	gc.restConfig = rc
+	k8sClient, err := kubernetes.NewForConfig(gc.restConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	ctx := context.Background()
+	snap, err := collectClusterSnapshot(ctx, k8sClient, gc)
+	if err != nil {
+		return "", err
+	}
+	doc := core.NewSnapshotDocument(snap.Snapshot, snap.documentMetadata())
+
+	namespace := ""
+	if gc.configFlags.Namespace != nil {
+		namespace = *gc.configFlags.Namespace
+	}
+	in, err := collectCheckInput(ctx, k8sClient, doc, namespace, policy.scopes())
+	if err != nil {
+		return "", err
+	}
+
+	results := evaluateCheckPolicy(policy, in)
+	if err := renderCheckResults(results, doc.Metadata); err != nil {
+		return "", err
+	}
+	if junitFile != "" {
+		if err := writeCheckJUnitFile(junitFile, results, doc.Metadata); err != nil {
+			return "", err
+		}
+	}
+	return overallCheckStatus(results), nil
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testCheckPolicy = `
+rules:
+  - name: cluster-cpu-requests
+    scope: cluster
+    metric: cpu.requestsPercent
+    warn: 75
+    fail: 85
+  - name: node-memory-usage
+    scope: node
+    metric: memory.usagePercent
+    fail: 90
+  - name: namespace-quota
+    scope: namespace
+    metric: quotaPercent
+    fail: 95
+    exclude: ["kube-*"]
+  - name: no-spot-only-deployments
+    scope: deployment
+    metric: spotOnly
+`
+
+func TestParseCheckPolicy(t *testing.T) {
+	policy, err := parseCheckPolicy([]byte(testCheckPolicy))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(policy.Rules) != 4 || policy.Rules[3].Severity != checkFail {
+		t.Errorf("expected boolean rules to default to fail, got %+v", policy.Rules)
+	}
+	scopes := policy.scopes()
+	if !scopes[checkScopeCluster] || !scopes[checkScopeDeployment] || len(scopes) != 4 {
+		t.Errorf("unexpected scopes: %v", scopes)
+	}
+
+	invalid := map[string]string{
+		"empty":          `rules: []`,
+		"unknown field":  "rules:\n- {name: a, scope: node, metric: oomKills, fail: 1, treshold: 2}",
+		"no name":        "rules:\n- {scope: node, metric: oomKills, fail: 1}",
+		"duplicate":      "rules:\n- {name: a, scope: node, metric: oomKills, fail: 1}\n- {name: a, scope: node, metric: oomKills, fail: 1}",
+		"unknown scope":  "rules:\n- {name: a, scope: pod, metric: oomKills, fail: 1}",
+		"unknown metric": "rules:\n- {name: a, scope: namespace, metric: oomKills, fail: 1}",
+		"no threshold":   "rules:\n- {name: a, scope: node, metric: oomKills}",
+		"warn over fail": "rules:\n- {name: a, scope: node, metric: oomKills, warn: 5, fail: 1}",
+		"bool threshold": "rules:\n- {name: a, scope: deployment, metric: spotOnly, fail: 0}",
+		"bad severity":   "rules:\n- {name: a, scope: deployment, metric: spotOnly, severity: error}",
+		"bad pattern":    "rules:\n- {name: a, scope: node, metric: oomKills, fail: 1, exclude: ['[']}",
+	}
+	for name, data := range invalid {
+		if _, err := parseCheckPolicy([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func newCheckTestDocument(metrics bool) core.SnapshotDocument {
+	node := func(name, cpuReq, memUsage string) testNode {
+		return testNode{name: name, status: nodeStatusReady, cpu: "4", memory: "10Gi",
+			cpuReq: cpuReq, memReq: "1Gi", cpuUsage: "1", memUsage: memUsage}
+	}
+	nm, totals := buildTestSnapshot(metrics,
+		node("node-a", "3", "9500Mi"),
+		node("node-b", "3600m", "2Gi"),
+		node("spot-1", "1", "1Gi"),
+	)
+	return core.NewSnapshotDocument(core.NewSnapshot(*nm, *totals), core.DocumentMetadata{Context: "prod"})
+}
+
+func newCheckTestClient() *fake.Clientset {
+	controller := true
+	replicaSetPod := func(name, node string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name, Namespace: "shop",
+				Labels: map[string]string{"pod-template-hash": "abc"},
+				OwnerReferences: []metav1.OwnerReference{{
+					Kind: "ReplicaSet", Name: strings.SplitN(name, "-", 2)[0] + "-abc", Controller: &controller,
+				}},
+			},
+			Spec:   v1.PodSpec{NodeName: node},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+	deployment := func(name string, replicas, available int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: available, AvailableReplicas: available},
+		}
+	}
+	quota := func(ns, name string, hard, used v1.ResourceList) *v1.ResourceQuota {
+		return &v1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Status:     v1.ResourceQuotaStatus{Hard: hard, Used: used},
+		}
+	}
+
+	return fake.NewSimpleClientset(
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "spot-1",
+			Labels: map[string]string{"karpenter.sh/capacity-type": "spot"}}},
+		deployment("web", 2, 2),
+		deployment("worker", 2, 1),
+		replicaSetPod("web-1", "spot-1"),
+		replicaSetPod("web-2", "spot-1"),
+		replicaSetPod("worker-1", "spot-1"),
+		replicaSetPod("worker-2", "node-a"),
+		quota("shop", "compute",
+			v1.ResourceList{v1.ResourceRequestsCPU: resource.MustParse("10"), v1.ResourcePods: resource.MustParse("20")},
+			v1.ResourceList{v1.ResourceRequestsCPU: resource.MustParse("9800m"), v1.ResourcePods: resource.MustParse("4")}),
+		quota("kube-system", "compute",
+			v1.ResourceList{v1.ResourcePods: resource.MustParse("10")},
+			v1.ResourceList{v1.ResourcePods: resource.MustParse("10")}),
+		quota("batch", "compute",
+			v1.ResourceList{v1.ResourceLimitsMemory: resource.MustParse("8Gi")},
+			v1.ResourceList{v1.ResourceLimitsMemory: resource.MustParse("2Gi")}),
+	)
+}
+
+func checkResultByRule(t *testing.T, results []checkResult, rule string) checkResult {
+	t.Helper()
+	for _, r := range results {
+		if r.Rule == rule {
+			return r
+		}
+	}
+	t.Fatalf("no result for rule %s", rule)
+	return checkResult{}
+}
+
+func TestEvaluateCheckPolicy(t *testing.T) {
+	policy, err := parseCheckPolicy([]byte(testCheckPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := newCheckTestDocument(true)
+	in, err := collectCheckInput(context.Background(), newCheckTestClient(), doc, "", policy.scopes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results := evaluateCheckPolicy(policy, in)
+
+	// 7.6 of 12 cores requested: 63.3%, below warn.
+	cpu := checkResultByRule(t, results, "cluster-cpu-requests")
+	if cpu.Status != checkPass || cpu.Message != "63.3%" {
+		t.Errorf("unexpected cluster rule result: %+v", cpu)
+	}
+
+	mem := checkResultByRule(t, results, "node-memory-usage")
+	if mem.Status != checkFail || len(mem.Violations) != 1 || mem.Violations[0].Subject != "node-a" {
+		t.Errorf("unexpected node rule result: %+v", mem)
+	}
+	if !strings.Contains(mem.Message, "1 of 3 nodes") || !strings.Contains(mem.Message, "node-a (92.8%)") {
+		t.Errorf("unexpected node rule message: %q", mem.Message)
+	}
+
+	// kube-system is excluded; shop is at 98% of its CPU requests quota.
+	quota := checkResultByRule(t, results, "namespace-quota")
+	if quota.Status != checkFail || quota.Checked != 2 || len(quota.Violations) != 1 {
+		t.Fatalf("unexpected quota rule result: %+v", quota)
+	}
+	if v := quota.Violations[0]; v.Subject != "shop" || v.Value != 98 || !strings.Contains(v.Note, "requests.cpu") {
+		t.Errorf("unexpected quota violation: %+v", v)
+	}
+
+	// web runs only on spot nodes; worker has one on-demand replica.
+	spot := checkResultByRule(t, results, "no-spot-only-deployments")
+	if spot.Status != checkFail || len(spot.Violations) != 1 || spot.Violations[0].Subject != "shop/web" {
+		t.Errorf("unexpected spot rule result: %+v", spot)
+	}
+
+	if status := overallCheckStatus(results); status != checkFail || checkExitCode(status) != checkExitFail {
+		t.Errorf("expected overall fail with exit code 2, got %s", status)
+	}
+}
+
+func TestEvaluateCheckPolicy_WarnAndUnknown(t *testing.T) {
+	policy, err := parseCheckPolicy([]byte(`
+rules:
+  - {name: cpu-requests, scope: node, metric: cpu.requestsPercent, warn: 70, fail: 95}
+  - {name: memory-usage, scope: node, metric: memory.usagePercent, fail: 90}
+  - {name: not-ready, scope: node, metric: notReady, severity: warn}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := newCheckTestDocument(false)
+	in, err := collectCheckInput(context.Background(), fake.NewSimpleClientset(), doc, "", policy.scopes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := evaluateCheckPolicy(policy, in)
+
+	cpu := checkResultByRule(t, results, "cpu-requests")
+	if cpu.Status != checkWarn || len(cpu.Violations) != 2 || cpu.Violations[0].Subject != "node-b" {
+		t.Errorf("expected node-b then node-a to warn, got %+v", cpu)
+	}
+
+	// Usage cannot be verified without metrics, so the rule warns.
+	mem := checkResultByRule(t, results, "memory-usage")
+	if mem.Status != checkWarn || len(mem.Unknown) != 3 || !strings.Contains(mem.Message, "usage metrics unavailable") {
+		t.Errorf("expected unknown usage to warn, got %+v", mem)
+	}
+
+	ready := checkResultByRule(t, results, "not-ready")
+	if ready.Status != checkPass || ready.Message != "none of 3 nodes" {
+		t.Errorf("unexpected not-ready result: %+v", ready)
+	}
+
+	if status := overallCheckStatus(results); checkExitCode(status) != checkExitWarn {
+		t.Errorf("expected exit code 1, got %d", checkExitCode(status))
+	}
+}
+
+func TestRenderCheckResults(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	fail := 90.0
+	results := []checkResult{
+		{Rule: "cpu", Scope: checkScopeCluster, Metric: "cpu.requestsPercent", Status: checkPass, Message: "63.3%", Checked: 1},
+		{Rule: "memory", Scope: checkScopeNode, Metric: "memory.usagePercent", Fail: &fail, Status: checkFail,
+			Message: "1 of 3 nodes", Checked: 3,
+			Violations: []checkViolation{{Subject: "node-a", Status: checkFail, Value: 92.8}}},
+		{Rule: "spot", Scope: checkScopeDeployment, Metric: "spotOnly", Status: checkWarn, Message: "1 of 2 deployments", Checked: 2},
+	}
+	meta := core.DocumentMetadata{Context: "prod"}
+
+	viper.Set("output", "txt")
+	out := captureOutput(func() {
+		if err := renderCheckResults(results, meta); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	for _, want := range []string{"RULE", "PASS", "FAIL", "WARN", "1 passed, 1 warnings, 1 failed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected table to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	viper.Set("output", outputFormatJSON)
+	out = captureOutput(func() {
+		if err := renderCheckResults(results, meta); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	var report checkReport
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("expected JSON, got %v:\n%s", err, out)
+	}
+	if report.Kind != "CheckReport" || report.Status != checkFail || len(report.Results) != 3 {
+		t.Errorf("unexpected JSON report: %+v", report)
+	}
+
+	viper.Set("output", "jsonpath={.items[?(@.status==\"fail\")].rule}")
+	out = captureOutput(func() {
+		if err := renderCheckResults(results, meta); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	if strings.TrimSpace(out) != results[1].Rule {
+		t.Errorf("expected the failing rule %q from jsonpath, got %q", results[1].Rule, out)
+	}
+
+	viper.Set("output", outputFormatYAML)
+	out = captureOutput(func() {
+		if err := renderCheckResults(results, meta); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	if !strings.Contains(out, "kind: CheckReport") {
+		t.Errorf("expected a YAML CheckReport, got:\n%s", out)
+	}
+
+	for _, output := range []string{outputFormatCSV, outputFormatHTML, outputFormatChart} {
+		viper.Set("output", output)
+		if err := renderCheckResults(results, meta); err == nil || !strings.Contains(err.Error(), "not supported by check") {
+			t.Errorf("-o %s: expected an unsupported format error, got %v", output, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := writeCheckJUnit(&buf, results, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var suites junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &suites); err != nil {
+		t.Fatalf("expected JUnit XML, got %v:\n%s", err, buf.String())
+	}
+	if suites.Tests != 3 || suites.Failures != 1 || len(suites.Suites) != 1 {
+		t.Fatalf("unexpected JUnit counts: %+v", suites)
+	}
+	suite := suites.Suites[0]
+	if suite.Name != "glance check prod" || suite.Cases[1].Failure == nil ||
+		!strings.Contains(suite.Cases[1].Failure.Text, "FAIL node-a: 92.8%") {
+		t.Errorf("unexpected JUnit suite: %+v", suite)
+	}
+	if suite.Cases[0].Failure != nil || !strings.HasPrefix(suite.Cases[2].SystemOut, "WARN: ") {
+		t.Errorf("expected pass without failure and warn in system-out, got %+v", suite.Cases)
+	}
+}
+
+func TestWriteCheckJUnitFile(t *testing.T) {
+	results := []checkResult{{Rule: "cpu", Scope: "node", Status: checkPass}}
+	path := filepath.Join(t.TempDir(), "report.xml")
+	if err := writeCheckJUnitFile(path, results, core.DocumentMetadata{}); err != nil {
+		t.Fatalf("writeCheckJUnitFile() error = %v", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var suites junitTestSuites
+	if err := xml.Unmarshal(b, &suites); err != nil || suites.Tests != 1 {
+		t.Errorf("unexpected JUnit file (%v):\n%s", err, b)
+	}
+
+	err = writeCheckJUnitFile(filepath.Join(t.TempDir(), "missing", "report.xml"), results, core.DocumentMetadata{})
+	if err == nil || !strings.Contains(err.Error(), "failed to create JUnit file") {
+		t.Errorf("writeCheckJUnitFile() into a missing directory error = %v", err)
+	}
+}
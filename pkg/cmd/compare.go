@@ -0,0 +1,505 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	pt "github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	metricsV1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// noNodeGroup names the bucket for nodes without a node group/pool label.
+const noNodeGroup = "(none)"
+
+// CompareValues holds one cluster's side of a comparison row.
+type CompareValues struct {
+	Nodes    int   `json:",omitempty"` // node groups
+	Pods     int   `json:",omitempty"` // namespaces
+	Replicas int32 `json:",omitempty"` // deployments
+	Ready    int32 `json:",omitempty"` // deployments
+	CPUReq   *resource.Quantity
+	CPULimit *resource.Quantity
+	CPUUsage *resource.Quantity `json:",omitempty"`
+	MemReq   *resource.Quantity
+	MemLimit *resource.Quantity
+	MemUsage *resource.Quantity `json:",omitempty"`
+}
+
+// CompareRow aligns one node group, namespace or deployment across the two
+// clusters. Left or Right is nil when the item only exists on the other side.
+type CompareRow struct {
+	Name  string
+	Left  *CompareValues `json:",omitempty"`
+	Right *CompareValues `json:",omitempty"`
+	// Drift is true when the item is missing on one side or its node count,
+	// replicas, requests or limits differ. Usage and ready counts are
+	// runtime values and never count as drift.
+	Drift bool
+}
+
+// CompareReport is the result of "glance compare".
+type CompareReport struct {
+	Left        string
+	Right       string
+	NodeGroups  []CompareRow
+	Namespaces  []CompareRow
+	Deployments []CompareRow
+	// DriftCount is the number of rows with Drift set, for scripted checks.
+	DriftCount int
+}
+
+// compareSide is one cluster's values keyed by node group, namespace and
+// namespace/deployment.
+type compareSide struct {
+	nodeGroups  map[string]*CompareValues
+	namespaces  map[string]*CompareValues
+	deployments map[string]*CompareValues
+}
+
+// NewCompareCmd creates the "glance compare" subcommand.
+func NewCompareCmd(gc *GlanceConfig) *cobra.Command {
+	var contexts []string
+	var driftOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "compare --context LEFT --context RIGHT",
+		Short: "Compare node groups, namespaces and deployments across two clusters",
+		Long: `Align node groups, namespaces and deployments by name across two kubeconfig
+contexts and show requests, limits, usage and replica counts side by side.
+
+Values that differ are shown as "left → right"; items that exist in only one
+cluster are marked. Respects --namespace/-n, --selector and --output
+(-o json prints the full report, including a DriftCount for automated checks;
+custom-columns, jsonpath and go-template see one row per node group,
+namespace and deployment).`,
+		Example: `  kubectl glance compare --context staging --context prod
+  kubectl glance compare --context staging --context prod --drift-only -o json`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(contexts) != 2 {
+				return fmt.Errorf("compare needs exactly two --context flags, got %d", len(contexts))
+			}
+			raw, err := gc.configFlags.ToRawKubeConfigLoader().RawConfig()
+			if err != nil {
+				return fmt.Errorf("failed to load kubeconfig: %w", err)
+			}
+			if _, err := resolveFleetContexts(raw, contexts, false); err != nil {
+				return err
+			}
+
+			selector, err := getLabelSelector()
+			if err != nil {
+				return fmt.Errorf("invalid label/field selector: %w", err)
+			}
+			namespace := ""
+			if gc.configFlags.Namespace != nil {
+				namespace = *gc.configFlags.Namespace
+			}
+
+			sides := make([]*compareSide, 2)
+			g, ctx := errgroup.WithContext(context.Background())
+			for i, name := range contexts {
+				g.Go(func() error {
+					side, err := collectCompareSide(ctx, gc, name, namespace, selector)
+					if err != nil {
+						return fmt.Errorf("context %s: %w", name, err)
+					}
+					sides[i] = side
+					return nil
+				})
+			}
+			if err := g.Wait(); err != nil {
+				return err
+			}
+
+			report := buildCompareReport(contexts[0], contexts[1], sides[0], sides[1])
+			if driftOnly {
+				report = report.driftOnly()
+			}
+			return renderCompare(report)
+		},
+	}
+
+	// Shadows the kubeconfig --context flag so it can be given twice.
+	cmd.Flags().StringArrayVar(&contexts, "context", nil, "Kubeconfig context to compare (give exactly two)")
+	cmd.Flags().BoolVar(&driftOnly, "drift-only", false, "Only show rows that differ between the clusters")
+
+	return cmd
+}
+
+// collectCompareSide gathers node, pod and deployment data for one context.
+func collectCompareSide(
+	ctx context.Context,
+	base *GlanceConfig,
+	contextName, namespace string,
+	selector labels.Selector,
+) (*compareSide, error) {
+	cgc, err := contextGlanceConfig(base, contextName)
+	if err != nil {
+		return nil, err
+	}
+	k8sClient, err := kubernetes.NewForConfig(cgc.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+	metricsMode, metricsSource, err := resolveMetricsSource(cgc)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, fmt.Errorf("error getting Node list: %w", err)
+	}
+	podsByNode, _, err := buildNonTerminatedPodsByNode(ctx, k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	var nodeMetrics map[string]*metricsV1beta1api.NodeMetrics
+	if metricsSource != nil {
+		nodeMetrics, err = metricsSource.NodeMetrics(ctx)
+		if err != nil {
+			if metricsMode == metricsModeRequired {
+				return nil, metricsRequiredError(metricsSource, err)
+			}
+			log.Warnf("Context %s: usage metrics unavailable, comparing allocation only: %v", contextName, err)
+		}
+	}
+	nm, _, err := core.ComputeNodeSnapshot(nodes.Items, podsByNode, nodeMetrics, core.NodeSnapshotOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := metav1.ListOptions{ResourceVersion: "0"}
+	if selector != nil && !selector.Empty() {
+		listOptions.LabelSelector = selector.String()
+	}
+	podList, err := k8sClient.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect pod stats: %w", err)
+	}
+	pods := summarizePods(ctx, podList.Items, metricsSource, namespace)
+	if err := requirePodMetrics(metricsMode, metricsSource, pods); err != nil {
+		return nil, err
+	}
+	deployments, err := CollectDeploymentStats(ctx, k8sClient, namespace, selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect deployment stats: %w", err)
+	}
+
+	return buildCompareSide(nodes.Items, nm, pods, podDeployments(podList.Items), deployments), nil
+}
+
+// podDeployments returns the namespace/name of the Deployment owning each
+// pod, or "" for pods that no Deployment owns.
+func podDeployments(pods []v1.Pod) []string {
+	owners := make([]string, len(pods))
+	for i := range pods {
+		if kind, name := core.WorkloadOf(&pods[i]); kind == core.WorkloadDeployment {
+			owners[i] = pods[i].Namespace + "/" + name
+		}
+	}
+	return owners
+}
+
+// buildCompareSide aggregates nodes by node group and pods by namespace, and
+// keys deployments by namespace/name. podOwners holds the owning deployment
+// of each pod (see podDeployments), whose usage is summed per deployment.
+func buildCompareSide(
+	nodes []v1.Node,
+	nm core.NodeMap,
+	pods []PodSummaryRow,
+	podOwners []string,
+	deployments []DeploymentSummaryRow,
+) *compareSide {
+	side := &compareSide{
+		nodeGroups:  make(map[string]*CompareValues),
+		namespaces:  make(map[string]*CompareValues),
+		deployments: make(map[string]*CompareValues),
+	}
+
+	for i := range nodes {
+		stats, ok := nm[nodes[i].Name]
+		if !ok {
+			continue
+		}
+		group := extractNodeGroupFromLabels(nodes[i].Labels)
+		if group == "" {
+			group = noNodeGroup
+		}
+		v := side.nodeGroups[group]
+		if v == nil {
+			v = &CompareValues{}
+			side.nodeGroups[group] = v
+		}
+		v.Nodes++
+		addQuantity(&v.CPUReq, &stats.AllocatedCPUrequests)
+		addQuantity(&v.CPULimit, &stats.AllocatedCPULimits)
+		addQuantity(&v.CPUUsage, stats.UsageCPU)
+		addQuantity(&v.MemReq, &stats.AllocatedMemoryRequests)
+		addQuantity(&v.MemLimit, &stats.AllocatedMemoryLimits)
+		addQuantity(&v.MemUsage, stats.UsageMemory)
+	}
+
+	metricsAvailable := false
+	deploymentUsage := make(map[string]*CompareValues)
+	for i, p := range pods {
+		if p.MetricsAvailable {
+			metricsAvailable = true
+			if i < len(podOwners) && podOwners[i] != "" {
+				u := deploymentUsage[podOwners[i]]
+				if u == nil {
+					u = &CompareValues{}
+					deploymentUsage[podOwners[i]] = u
+				}
+				addQuantity(&u.CPUUsage, p.CPUUsage)
+				addQuantity(&u.MemUsage, p.MemUsage)
+			}
+		}
+
+		v := side.namespaces[p.Namespace]
+		if v == nil {
+			v = &CompareValues{}
+			side.namespaces[p.Namespace] = v
+		}
+		v.Pods++
+		addQuantity(&v.CPUReq, p.CPUReq)
+		addQuantity(&v.CPULimit, p.CPULimit)
+		addQuantity(&v.MemReq, p.MemReq)
+		addQuantity(&v.MemLimit, p.MemLimit)
+		if p.MetricsAvailable {
+			addQuantity(&v.CPUUsage, p.CPUUsage)
+			addQuantity(&v.MemUsage, p.MemUsage)
+		}
+	}
+
+	for _, d := range deployments {
+		key := d.Namespace + "/" + d.Name
+		v := &CompareValues{
+			Replicas: d.Replicas,
+			Ready:    d.Ready,
+			CPUReq:   d.CPUReq,
+			CPULimit: d.CPULimit,
+			MemReq:   d.MemReq,
+			MemLimit: d.MemLimit,
+		}
+		// Usage is unknown without metrics; a deployment without running
+		// pods uses nothing.
+		if metricsAvailable {
+			v.CPUUsage = resource.NewMilliQuantity(0, resource.DecimalSI)
+			v.MemUsage = resource.NewQuantity(0, resource.BinarySI)
+			if u := deploymentUsage[key]; u != nil {
+				addQuantity(&v.CPUUsage, u.CPUUsage)
+				addQuantity(&v.MemUsage, u.MemUsage)
+			}
+		}
+		side.deployments[key] = v
+	}
+
+	return side
+}
+
+// buildCompareReport aligns the two sides by name.
+func buildCompareReport(leftName, rightName string, left, right *compareSide) CompareReport {
+	report := CompareReport{
+		Left:        leftName,
+		Right:       rightName,
+		NodeGroups:  alignCompareRows(left.nodeGroups, right.nodeGroups),
+		Namespaces:  alignCompareRows(left.namespaces, right.namespaces),
+		Deployments: alignCompareRows(left.deployments, right.deployments),
+	}
+	for _, rows := range [][]CompareRow{report.NodeGroups, report.Namespaces, report.Deployments} {
+		for _, r := range rows {
+			if r.Drift {
+				report.DriftCount++
+			}
+		}
+	}
+	return report
+}
+
+// alignCompareRows returns one row per name found on either side, sorted by
+// name.
+func alignCompareRows(left, right map[string]*CompareValues) []CompareRow {
+	names := make(map[string]bool, len(left)+len(right))
+	for name := range left {
+		names[name] = true
+	}
+	for name := range right {
+		names[name] = true
+	}
+
+	rows := make([]CompareRow, 0, len(names))
+	for name := range names {
+		l, r := left[name], right[name]
+		rows = append(rows, CompareRow{Name: name, Left: l, Right: r, Drift: compareValuesDrift(l, r)})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Name < rows[j].Name })
+	return rows
+}
+
+// compareValuesDrift reports whether the configured values differ.
+func compareValuesDrift(l, r *CompareValues) bool {
+	if l == nil || r == nil {
+		return true
+	}
+	return l.Nodes != r.Nodes || l.Replicas != r.Replicas ||
+		!quantitiesEqual(l.CPUReq, r.CPUReq) || !quantitiesEqual(l.CPULimit, r.CPULimit) ||
+		!quantitiesEqual(l.MemReq, r.MemReq) || !quantitiesEqual(l.MemLimit, r.MemLimit)
+}
+
+// quantitiesEqual compares two quantities, treating nil as zero.
+func quantitiesEqual(a, b *resource.Quantity) bool {
+	var za, zb resource.Quantity
+	if a != nil {
+		za = *a
+	}
+	if b != nil {
+		zb = *b
+	}
+	return za.Cmp(zb) == 0
+}
+
+// driftOnly returns a copy of the report keeping only rows with drift.
+func (r CompareReport) driftOnly() CompareReport {
+	filter := func(rows []CompareRow) []CompareRow {
+		out := make([]CompareRow, 0, len(rows))
+		for _, row := range rows {
+			if row.Drift {
+				out = append(out, row)
+			}
+		}
+		return out
+	}
+	r.NodeGroups = filter(r.NodeGroups)
+	r.Namespaces = filter(r.Namespaces)
+	r.Deployments = filter(r.Deployments)
+	return r
+}
+
+// renderCompare renders a comparison report according to the global output
+// format.
+func renderCompare(report CompareReport) error {
+	output := viper.GetString("output")
+	if isTemplateFormat(output) {
+		return renderTemplateOutput(output, compareRows(report))
+	}
+	if isReportFormat(output) {
+		return fmt.Errorf("output format %q is not supported by compare; use -o json or a template format", output)
+	}
+	if output == outputFormatJSON {
+		b, err := json.MarshalIndent(report, "", "\t")
+		if err != nil {
+			log.Errorf("failed to marshal comparison to JSON: %v", err)
+			return fmt.Errorf("failed to render comparison JSON output: %w", err)
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	fmt.Printf("\n  Comparing %s → %s   (%d difference(s))\n", report.Left, report.Right, report.DriftCount)
+	renderCompareSection(report, "NODE GROUP", "NODES", report.NodeGroups, output == outputFormatPretty,
+		func(v *CompareValues) string { return fmt.Sprintf("%d", v.Nodes) })
+	renderCompareSection(report, "NAMESPACE", "PODS", report.Namespaces, output == outputFormatPretty,
+		func(v *CompareValues) string { return fmt.Sprintf("%d", v.Pods) })
+	renderCompareSection(report, "DEPLOYMENT", "REPLICAS", report.Deployments, output == outputFormatPretty,
+		func(v *CompareValues) string { return fmt.Sprintf("%d/%d", v.Ready, v.Replicas) })
+	return nil
+}
+
+// renderCompareSection prints one aligned table. count formats the
+// section-specific count column.
+func renderCompareSection(report CompareReport, nameHeader, countHeader string, rows []CompareRow, pretty bool,
+	count func(v *CompareValues) string) {
+	if len(rows) == 0 {
+		return
+	}
+
+	t := pt.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	if pretty {
+		t.SetStyle(pt.StyleRounded)
+	} else {
+		t.SetStyle(pt.StyleLight)
+	}
+
+	t.AppendHeader(pt.Row{nameHeader, "DRIFT", countHeader,
+		"CPU REQ", "CPU LIM", "CPU USE", "MEM REQ", "MEM LIM", "MEM USE"})
+
+	for _, row := range rows {
+		drift := ""
+		switch {
+		case row.Right == nil:
+			drift = "only " + report.Left
+		case row.Left == nil:
+			drift = "only " + report.Right
+		case row.Drift:
+			drift = "≠"
+		}
+		if drift != "" {
+			drift = text.FgYellow.Sprint(drift)
+		}
+
+		pair := func(f func(v *CompareValues) string) string {
+			return formatComparePair(row.Left, row.Right, f)
+		}
+		t.AppendRow(pt.Row{
+			row.Name,
+			drift,
+			pair(count),
+			pair(func(v *CompareValues) string { return formatQuantity(v.CPUReq) }),
+			pair(func(v *CompareValues) string { return formatQuantity(v.CPULimit) }),
+			pair(func(v *CompareValues) string { return formatUsage(v.CPUUsage) }),
+			pair(func(v *CompareValues) string { return formatQuantity(v.MemReq) }),
+			pair(func(v *CompareValues) string { return formatQuantity(v.MemLimit) }),
+			pair(func(v *CompareValues) string { return formatUsage(v.MemUsage) }),
+		})
+	}
+
+	fmt.Println()
+	t.Render()
+}
+
+// formatComparePair renders one cell as "left → right", or a single value
+// when both sides match. A missing side is shown as "-".
+func formatComparePair(left, right *CompareValues, f func(v *CompareValues) string) string {
+	l, r := "-", "-"
+	if left != nil {
+		l = f(left)
+	}
+	if right != nil {
+		r = f(right)
+	}
+	if l == r {
+		return l
+	}
+	return l + " → " + r
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func qp(s string) *resource.Quantity {
+	q := resource.MustParse(s)
+	return &q
+}
+
+func testCompareSide(apiReplicas int32, apiCPU string, extraNS bool) *compareSide {
+	nodes := []v1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: map[string]string{"eks.amazonaws.com/nodegroup": "general"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "n2", Labels: map[string]string{"eks.amazonaws.com/nodegroup": "general"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "n3"}},
+	}
+	nm := core.NodeMap{
+		"n1": {AllocatedCPUrequests: resource.MustParse("1"), UsageCPU: qp("500m")},
+		"n2": {AllocatedCPUrequests: resource.MustParse("2"), UsageCPU: qp("1")},
+		"n3": {},
+	}
+	pods := []PodSummaryRow{
+		{Namespace: "payments", Name: "api-0", CPUReq: qp(apiCPU), MemReq: qp("1Gi"), MetricsAvailable: true,
+			CPUUsage: qp("100m"), MemUsage: qp("300Mi")},
+		{Namespace: "payments", Name: "api-1", CPUReq: qp(apiCPU), MemReq: qp("1Gi"), MetricsAvailable: true,
+			CPUUsage: qp("200m"), MemUsage: qp("200Mi")},
+	}
+	owners := []string{"payments/api", "payments/api"}
+	if extraNS {
+		pods = append(pods, PodSummaryRow{Namespace: "canary", Name: "c-0", CPUReq: qp("100m"), MetricsAvailable: true})
+		owners = append(owners, "")
+	}
+	deps := []DeploymentSummaryRow{
+		{Namespace: "payments", Name: "api", Replicas: apiReplicas, Ready: apiReplicas, CPUReq: qp(apiCPU)},
+	}
+	return buildCompareSide(nodes, nm, pods, owners, deps)
+}
+
+func TestBuildCompareSide(t *testing.T) {
+	side := testCompareSide(2, "250m", false)
+
+	general := side.nodeGroups["general"]
+	if general == nil || general.Nodes != 2 || general.CPUReq.MilliValue() != 3000 || general.CPUUsage.MilliValue() != 1500 {
+		t.Errorf("unexpected general node group: %+v", general)
+	}
+	if none := side.nodeGroups[noNodeGroup]; none == nil || none.Nodes != 1 {
+		t.Errorf("expected unlabeled node in %s group, got %+v", noNodeGroup, none)
+	}
+	ns := side.namespaces["payments"]
+	if ns == nil || ns.Pods != 2 || ns.CPUReq.MilliValue() != 500 || ns.CPUUsage.MilliValue() != 300 {
+		t.Errorf("unexpected payments namespace: %+v", ns)
+	}
+	d := side.deployments["payments/api"]
+	if d == nil || d.Replicas != 2 {
+		t.Fatalf("unexpected deployment: %+v", d)
+	}
+	if d.CPUUsage == nil || d.CPUUsage.MilliValue() != 300 || d.MemUsage == nil || d.MemUsage.Value() != 500*1024*1024 {
+		t.Errorf("expected deployment usage summed from its pods, got cpu %v mem %v", d.CPUUsage, d.MemUsage)
+	}
+}
+
+func TestPodDeployments(t *testing.T) {
+	controller := true
+	pods := []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "payments", Name: "api-7d9f-x2",
+			Labels:          map[string]string{"pod-template-hash": "7d9f"},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "api-7d9f", Controller: &controller}},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "payments", Name: "db-0",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "db", Controller: &controller}},
+		}},
+	}
+	got := podDeployments(pods)
+	if len(got) != 2 || got[0] != "payments/api" || got[1] != "" {
+		t.Errorf("podDeployments() = %q, want [payments/api \"\"]", got)
+	}
+}
+
+func TestBuildCompareReport_Drift(t *testing.T) {
+	staging := testCompareSide(2, "250m", false)
+	prod := testCompareSide(4, "500m", true)
+
+	report := buildCompareReport("staging", "prod", staging, prod)
+
+	if len(report.NodeGroups) != 2 || report.NodeGroups[0].Drift || report.NodeGroups[1].Drift {
+		t.Errorf("expected identical node groups without drift, got %+v", report.NodeGroups)
+	}
+	if len(report.Namespaces) != 2 || report.Namespaces[0].Name != "canary" || report.Namespaces[0].Left != nil {
+		t.Fatalf("expected canary to exist only in prod, got %+v", report.Namespaces)
+	}
+	if !report.Namespaces[1].Drift {
+		t.Error("expected payments requests to drift")
+	}
+	if len(report.Deployments) != 1 || !report.Deployments[0].Drift {
+		t.Errorf("expected replica drift on payments/api, got %+v", report.Deployments)
+	}
+	if report.DriftCount != 3 {
+		t.Errorf("expected 3 differences, got %d", report.DriftCount)
+	}
+
+	if got := report.driftOnly(); len(got.NodeGroups) != 0 || len(got.Namespaces) != 2 {
+		t.Errorf("expected drift-only to drop matching rows, got %+v", got)
+	}
+}
+
+func TestRenderCompare(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	report := buildCompareReport("staging", "prod", testCompareSide(2, "250m", false), testCompareSide(4, "250m", true))
+
+	out := captureOutput(func() {
+		if err := renderCompare(report); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	for _, want := range []string{"Comparing staging → prod", "NODE GROUP", "general", "only prod", "2/2 → 4/4", "DEPLOYMENT"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	viper.Set("output", outputFormatJSON)
+	out = captureOutput(func() {
+		if err := renderCompare(report); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	var decoded CompareReport
+	if err := json.Unmarshal([]byte(out), &decoded); err != nil {
+		t.Fatalf("expected JSON report, got %v:\n%s", err, out)
+	}
+	if decoded.Left != "staging" || decoded.Right != "prod" || decoded.DriftCount != report.DriftCount {
+		t.Errorf("unexpected JSON report: %+v", decoded)
+	}
+}
+
+func TestRenderCompareTemplates(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	report := buildCompareReport("staging", "prod", testCompareSide(2, "250m", false), testCompareSide(4, "250m", true))
+
+	viper.Set("output", "custom-columns=SECTION:.section,NAME:.name,LEFT:.left.count,RIGHT:.right.count,USE:.right.usage.cpu")
+	out := captureOutput(func() {
+		if err := renderCompare(report); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("expected a header and 5 rows, got:\n%s", out)
+	}
+	if got := strings.Join(strings.Fields(lines[3]), " "); got != "namespace canary <none> 1 <none>" {
+		t.Errorf("unexpected canary row %q", got)
+	}
+	if got := strings.Join(strings.Fields(lines[5]), " "); got != "deployment payments/api 2 4 0.3" {
+		t.Errorf("unexpected deployment row %q", got)
+	}
+
+	viper.Set("output", `jsonpath={.items[?(@.drift==true)].name}`)
+	out = captureOutput(func() {
+		if err := renderCompare(report); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	if strings.TrimSpace(out) != "canary payments/api" {
+		t.Errorf("unexpected jsonpath output %q", out)
+	}
+
+	viper.Set("output", outputFormatCSV)
+	if err := renderCompare(report); err == nil {
+		t.Error("expected an error for -o csv")
+	}
+}
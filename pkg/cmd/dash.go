@@ -0,0 +1,443 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"sort"
+	"strings"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	outputFormatDash = "dash"
+	outputFormatPie  = "pie"
+)
+
+// Dashboard layout, in terminal cells.
+const (
+	dashHeaderHeight   = 3
+	dashTotalsHeight   = 8
+	dashSidebarMin     = 32
+	dashSidebarMax     = 48
+	dashGaugeHeight    = 3
+	dashGaugeCellWidth = 60 // one node: CPU and memory gauges side by side
+	dashPieCellWidth   = 40
+	dashPieCellHeight  = 12
+	dashMinPieHeight   = 6
+	// dashMaxSlices limits the namespace pie; smaller namespaces are summed.
+	dashMaxSlices = 6
+)
+
+// dashPalette colors namespace slices; the names are used in the legend.
+var dashPalette = []struct {
+	color ui.Color
+	name  string
+}{
+	{ui.ColorCyan, "cyan"},
+	{ui.ColorMagenta, "magenta"},
+	{ui.ColorBlue, "blue"},
+	{ui.ColorGreen, "green"},
+	{ui.ColorYellow, "yellow"},
+	{ui.ColorWhite, "white"},
+}
+
+// dashboard is the state of the dash and pie outputs: a header, a cluster
+// totals panel and a namespace share pie beside one page of node panels
+// (gauges for dash, pies for pie).
+type dashboard struct {
+	pie        bool
+	nm         *core.NodeMap
+	totals     *core.Totals
+	namespaces *namespaceBreakdown
+	nodes      []string // sorted node names
+
+	page          int
+	namespaceMem  bool // namespace pie shows memory rather than CPU requests
+	width, height int  // terminal size of the last render
+}
+
+func newDashboard(nm *core.NodeMap, c *core.Totals, namespaces *namespaceBreakdown, pie bool) *dashboard {
+	nodes := make([]string, 0, len(*nm))
+	for name := range *nm {
+		nodes = append(nodes, name)
+	}
+	sort.Strings(nodes)
+	return &dashboard{pie: pie, nm: nm, totals: c, namespaces: namespaces, nodes: nodes}
+}
+
+// dash shows every node as a pair of CPU and memory gauges.
+func dash(nm *core.NodeMap, c *core.Totals, namespaces *namespaceBreakdown) error {
+	return runDashboard(newDashboard(nm, c, namespaces, false))
+}
+
+// pie shows every node as a pair of CPU and memory pies.
+func pie(nm *core.NodeMap, c *core.Totals, namespaces *namespaceBreakdown) error {
+	return runDashboard(newDashboard(nm, c, namespaces, true))
+}
+
+// runDashboard draws d until the user quits, redrawing on key presses and
+// terminal resizes.
+func runDashboard(d *dashboard) error {
+	if err := ui.Init(); err != nil {
+		return fmt.Errorf("failed to initialize termui: %w", err)
+	}
+	defer ui.Close()
+
+	draw := func() {
+		d.width, d.height = ui.TerminalDimensions()
+		ui.Clear()
+		ui.Render(d.widgets(d.width, d.height)...)
+	}
+	draw()
+
+	for e := range ui.PollEvents() {
+		if e.Type != ui.KeyboardEvent && e.Type != ui.ResizeEvent {
+			continue
+		}
+		if d.handleEvent(e.ID) {
+			return nil
+		}
+		draw()
+	}
+	return nil
+}
+
+// handleEvent applies a key press and reports whether to quit.
+func (d *dashboard) handleEvent(id string) bool {
+	pages := d.pageCount(d.width, d.height)
+	switch id {
+	case "q", ctlC, "<Escape>":
+		return true
+	case "<Right>", "<PageDown>", "<Space>", "n":
+		d.page++
+	case "<Left>", "<PageUp>", "p":
+		d.page--
+	case "<Home>":
+		d.page = 0
+	case "<End>":
+		d.page = pages - 1
+	case "m":
+		d.namespaceMem = !d.namespaceMem
+	}
+	d.page = max(0, min(d.page, pages-1))
+	return false
+}
+
+// dashLayout is the position of each dashboard panel.
+type dashLayout struct {
+	header, totals, pie, legend, nodes image.Rectangle
+	cols, rows                         int // node panels per row and column
+}
+
+func (d *dashboard) layout(width, height int) dashLayout {
+	sidebar := max(dashSidebarMin, min(width/3, dashSidebarMax))
+	l := dashLayout{
+		header: image.Rect(0, 0, width, dashHeaderHeight),
+		totals: image.Rect(0, dashHeaderHeight, sidebar, dashHeaderHeight+dashTotalsHeight),
+		nodes:  image.Rect(sidebar, dashHeaderHeight, width, height),
+	}
+
+	legendHeight := len(d.namespaceSlices()) + 2
+	legendTop := max(height-legendHeight, l.totals.Max.Y)
+	l.legend = image.Rect(0, legendTop, sidebar, height)
+	if legendTop-l.totals.Max.Y >= dashMinPieHeight {
+		l.pie = image.Rect(0, l.totals.Max.Y, sidebar, legendTop)
+	}
+
+	cellWidth, cellHeight := dashGaugeCellWidth, dashGaugeHeight
+	if d.pie {
+		cellWidth, cellHeight = dashPieCellWidth, dashPieCellHeight
+	}
+	l.cols = max(1, l.nodes.Dx()/cellWidth)
+	l.rows = max(1, l.nodes.Dy()/cellHeight)
+	return l
+}
+
+// pageCount returns the number of node pages at the given terminal size.
+func (d *dashboard) pageCount(width, height int) int {
+	l := d.layout(width, height)
+	perPage := l.cols * l.rows
+	return max(1, (len(d.nodes)+perPage-1)/perPage)
+}
+
+// widgets builds every panel for a terminal of the given size.
+func (d *dashboard) widgets(width, height int) []ui.Drawable {
+	l := d.layout(width, height)
+	perPage := l.cols * l.rows
+	pages := max(1, (len(d.nodes)+perPage-1)/perPage)
+	d.page = max(0, min(d.page, pages-1))
+
+	title := "glance dash"
+	if d.pie {
+		title = "glance pie"
+	}
+	header := widgets.NewParagraph()
+	header.Title = title
+	header.Text = fmt.Sprintf("Nodes %d-%d of %d (page %d/%d)  [←→/PgUp/PgDn] page  [m] namespace CPU/memory  [q] quit",
+		min(d.page*perPage+1, len(d.nodes)), min((d.page+1)*perPage, len(d.nodes)), len(d.nodes), d.page+1, pages)
+	header.SetRect(l.header.Min.X, l.header.Min.Y, l.header.Max.X, l.header.Max.Y)
+	drawables := []ui.Drawable{header, d.totalsPanel(l.totals)}
+	drawables = append(drawables, d.namespacePanels(l.pie, l.legend)...)
+
+	start := d.page * perPage
+	end := min(start+perPage, len(d.nodes))
+	cellWidth := l.nodes.Dx() / l.cols
+	cellHeight := l.nodes.Dy() / l.rows
+	if !d.pie {
+		cellHeight = dashGaugeHeight
+	}
+	for i, name := range d.nodes[start:end] {
+		x := l.nodes.Min.X + (i%l.cols)*cellWidth
+		y := l.nodes.Min.Y + (i/l.cols)*cellHeight
+		cell := image.Rect(x, y, x+cellWidth, y+cellHeight)
+		drawables = append(drawables, d.nodePanels(name, (*d.nm)[name], cell)...)
+	}
+	return drawables
+}
+
+// dashFigures are one resource of a node or of the cluster as percentages
+// of allocatable; usage is negative when unknown.
+type dashFigures struct {
+	requests, limits, usage float64
+}
+
+func newDashFigures(requests, limits, usage, allocatable *resource.Quantity, metrics bool) dashFigures {
+	f := dashFigures{
+		requests: calculatePercentage(requests, allocatable),
+		limits:   calculatePercentage(limits, allocatable),
+		usage:    -1,
+	}
+	if metrics && usage != nil {
+		f.usage = calculatePercentage(usage, allocatable)
+	}
+	return f
+}
+
+// primary is the percentage a gauge shows: usage when known, else requests.
+func (f dashFigures) primary() float64 {
+	if f.usage >= 0 {
+		return f.usage
+	}
+	return f.requests
+}
+
+func (f dashFigures) String() string {
+	use := usageNotAvailable
+	if f.usage >= 0 {
+		use = fmt.Sprintf("%.0f%%", f.usage)
+	}
+	return fmt.Sprintf("use %s  req %.0f%%  lim %.0f%%", use, f.requests, f.limits)
+}
+
+// dashColor colors a percentage by the live view thresholds.
+func dashColor(pct float64) ui.Color {
+	switch {
+	case pct >= thresholdHigh:
+		return ui.ColorRed
+	case pct >= thresholdMedium:
+		return ui.ColorYellow
+	default:
+		return ui.ColorGreen
+	}
+}
+
+func (d *dashboard) nodeFigures(v *core.NodeStats) (cpu, mem dashFigures) {
+	metrics := d.totals.MetricsAvailable
+	cpu = newDashFigures(&v.AllocatedCPUrequests, &v.AllocatedCPULimits, v.UsageCPU, v.AllocatableCPU, metrics)
+	mem = newDashFigures(&v.AllocatedMemoryRequests, &v.AllocatedMemoryLimits, v.UsageMemory, v.AllocatableMemory, metrics)
+	return cpu, mem
+}
+
+// nodePanels returns the CPU and memory panels of one node, side by side
+// in cell.
+func (d *dashboard) nodePanels(name string, v *core.NodeStats, cell image.Rectangle) []ui.Drawable {
+	cpu, mem := d.nodeFigures(v)
+	mid := cell.Min.X + cell.Dx()/2
+	suffix := ""
+	if v.Status != nodeStatusReady {
+		suffix = " (" + v.Status + ")"
+	}
+
+	panels := make([]ui.Drawable, 0, 2)
+	for i, r := range []struct {
+		label string
+		f     dashFigures
+		rect  image.Rectangle
+	}{
+		{"CPU", cpu, image.Rect(cell.Min.X, cell.Min.Y, mid, cell.Max.Y)},
+		{"MEM", mem, image.Rect(mid, cell.Min.Y, cell.Max.X, cell.Max.Y)},
+	} {
+		title := name + suffix + " " + r.label
+		if i > 0 {
+			title = r.label
+		}
+		if d.pie {
+			p := widgets.NewPieChart()
+			p.Title = fmt.Sprintf("%s %.0f%%", title, r.f.primary())
+			p.Data, p.Colors = dashNodePie(r.f)
+			p.SetRect(r.rect.Min.X, r.rect.Min.Y, r.rect.Max.X, r.rect.Max.Y)
+			if suffix != "" {
+				p.BorderStyle = ui.NewStyle(ui.ColorRed)
+			}
+			panels = append(panels, p)
+			continue
+		}
+		g := widgets.NewGauge()
+		g.Title = title
+		g.Percent = int(math.Max(0, math.Min(r.f.primary(), 100)))
+		g.Label = r.f.String()
+		g.BarColor = dashColor(r.f.primary())
+		g.SetRect(r.rect.Min.X, r.rect.Min.Y, r.rect.Max.X, r.rect.Max.Y)
+		if suffix != "" {
+			g.BorderStyle = ui.NewStyle(ui.ColorRed)
+		}
+		panels = append(panels, g)
+	}
+	return panels
+}
+
+// dashNodePie splits allocatable into used, requested but unused, and free
+// slices.
+func dashNodePie(f dashFigures) ([]float64, []ui.Color) {
+	used := math.Max(f.usage, 0)
+	requested := math.Max(f.requests-used, 0)
+	free := math.Max(100-used-requested, 0)
+	return []float64{used, requested, free}, []ui.Color{dashColor(f.primary()), ui.ColorCyan, ui.ColorWhite}
+}
+
+// totalsPanel summarizes the cluster.
+func (d *dashboard) totalsPanel(r image.Rectangle) ui.Drawable {
+	c := d.totals
+	ready := 0
+	pods := 0
+	for _, v := range *d.nm {
+		if v.Status == nodeStatusReady {
+			ready++
+		}
+		pods += v.PodCount
+	}
+	cpu := newDashFigures(c.TotalAllocatedCPUrequests, c.TotalAllocatedCPULimits, c.TotalUsageCPU, c.TotalAllocatableCPU, c.MetricsAvailable)
+	mem := newDashFigures(c.TotalAllocatedMemoryRequests, c.TotalAllocatedMemoryLimits, c.TotalUsageMemory, c.TotalAllocatableMemory, c.MetricsAvailable)
+
+	p := widgets.NewParagraph()
+	p.Title = "Cluster"
+	p.Text = strings.Join([]string{
+		fmt.Sprintf("Nodes   %d ready / %d", ready, len(*d.nm)),
+		fmt.Sprintf("Pods    %d (%d pending)", pods, c.PendingPods),
+		fmt.Sprintf("CPU     %s allocatable", formatMilliCPU(c.TotalAllocatableCPU)),
+		fmt.Sprintf("  [%s](fg:%s)", cpu, dashColorName(cpu.primary())),
+		fmt.Sprintf("Memory  %s allocatable", formatBytes(c.TotalAllocatableMemory)),
+		fmt.Sprintf("  [%s](fg:%s)", mem, dashColorName(mem.primary())),
+	}, "\n")
+	p.SetRect(r.Min.X, r.Min.Y, r.Max.X, r.Max.Y)
+	return p
+}
+
+// dashColorName is the termui style name of dashColor(pct).
+func dashColorName(pct float64) string {
+	switch dashColor(pct) {
+	case ui.ColorRed:
+		return "red"
+	case ui.ColorYellow:
+		return "yellow"
+	default:
+		return "green"
+	}
+}
+
+// dashSlice is one namespace slice of the share pie.
+type dashSlice struct {
+	name  string
+	value float64
+}
+
+// namespaceSlices returns the namespaces' share of CPU (or memory) requests,
+// largest first, summing those beyond dashMaxSlices into one slice.
+func (d *dashboard) namespaceSlices() []dashSlice {
+	if d.namespaces == nil {
+		return nil
+	}
+	var slices []dashSlice
+	for name, agg := range d.namespaces.groups {
+		v := cpuCores(&agg.CPURequests)
+		if d.namespaceMem {
+			v = quantityBytes(&agg.MemoryRequests)
+		}
+		if v > 0 {
+			slices = append(slices, dashSlice{name: name, value: v})
+		}
+	}
+	sort.Slice(slices, func(i, j int) bool {
+		if slices[i].value != slices[j].value {
+			return slices[i].value > slices[j].value
+		}
+		return slices[i].name < slices[j].name
+	})
+	if len(slices) <= dashMaxSlices {
+		return slices
+	}
+	other := dashSlice{name: fmt.Sprintf("(%d others)", len(slices)-dashMaxSlices+1)}
+	for _, s := range slices[dashMaxSlices-1:] {
+		other.value += s.value
+	}
+	return append(slices[:dashMaxSlices-1], other)
+}
+
+// namespacePanels returns the namespace share pie (when pieRect is not
+// empty) and its legend.
+func (d *dashboard) namespacePanels(pieRect, legendRect image.Rectangle) []ui.Drawable {
+	resourceName := "CPU"
+	if d.namespaceMem {
+		resourceName = "memory"
+	}
+	slices := d.namespaceSlices()
+
+	legend := widgets.NewParagraph()
+	legend.Title = "Namespaces by " + resourceName + " requests"
+	legend.SetRect(legendRect.Min.X, legendRect.Min.Y, legendRect.Max.X, legendRect.Max.Y)
+	if len(slices) == 0 {
+		legend.Text = "no requests"
+		return []ui.Drawable{legend}
+	}
+
+	total := 0.0
+	for _, s := range slices {
+		total += s.value
+	}
+	lines := make([]string, len(slices))
+	data := make([]float64, len(slices))
+	colors := make([]ui.Color, len(slices))
+	for i, s := range slices {
+		c := dashPalette[i%len(dashPalette)]
+		lines[i] = fmt.Sprintf("[■](fg:%s) %3.0f%% %s", c.name, s.value/total*100, s.name)
+		data[i], colors[i] = s.value, c.color
+	}
+	legend.Text = strings.Join(lines, "\n")
+	if pieRect.Empty() {
+		return []ui.Drawable{legend}
+	}
+
+	p := widgets.NewPieChart()
+	p.Title = "Share of requested " + resourceName
+	p.Data, p.Colors = data, colors
+	p.SetRect(pieRect.Min.X, pieRect.Min.Y, pieRect.Max.X, pieRect.Max.Y)
+	return []ui.Drawable{p, legend}
+}
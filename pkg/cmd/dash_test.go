@@ -0,0 +1,164 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gizak/termui/v3/widgets"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func newDashTestDashboard(nodes int, pie bool) *dashboard {
+	nm := make(NodeMap)
+	for i := 0; i < nodes; i++ {
+		nm[fmt.Sprintf("node-%02d", i)] = &NodeStats{
+			Status:               "Ready",
+			AllocatableCPU:       resource.NewMilliQuantity(4000, resource.DecimalSI),
+			AllocatableMemory:    resource.NewQuantity(8*1024*1024*1024, resource.BinarySI),
+			AllocatedCPUrequests: *resource.NewMilliQuantity(2000, resource.DecimalSI),
+			AllocatedCPULimits:   *resource.NewMilliQuantity(8000, resource.DecimalSI),
+			UsageCPU:             resource.NewMilliQuantity(3800, resource.DecimalSI),
+			UsageMemory:          resource.NewQuantity(1024*1024*1024, resource.BinarySI),
+			PodCount:             10,
+		}
+	}
+	totals := &Totals{
+		TotalAllocatableCPU:    resource.NewMilliQuantity(int64(4000*nodes), resource.DecimalSI),
+		TotalAllocatableMemory: resource.NewQuantity(int64(nodes)*8*1024*1024*1024, resource.BinarySI),
+		MetricsAvailable:       true,
+	}
+	groups := make(map[string]*core.ResourceAggregate)
+	for i := 0; i < 8; i++ {
+		groups[fmt.Sprintf("ns-%d", i)] = &core.ResourceAggregate{
+			CPURequests:    *resource.NewMilliQuantity(int64(100*(i+1)), resource.DecimalSI),
+			MemoryRequests: *resource.NewQuantity(int64(8-i)*1024*1024, resource.BinarySI),
+		}
+	}
+	d := newDashboard(&nm, totals, &namespaceBreakdown{groups: groups, usageKnown: true}, pie)
+	d.width, d.height = 120, 40
+	return d
+}
+
+func TestDashboard_Gauges(t *testing.T) {
+	d := newDashTestDashboard(3, false)
+
+	var gauges []*widgets.Gauge
+	for _, w := range d.widgets(d.width, d.height) {
+		if g, ok := w.(*widgets.Gauge); ok {
+			gauges = append(gauges, g)
+		}
+	}
+	if len(gauges) != 6 {
+		t.Fatalf("expected a CPU and a memory gauge per node, got %d gauges", len(gauges))
+	}
+	// CPU gauges show usage (95%), not limits.
+	cpu := gauges[0]
+	if !strings.HasPrefix(cpu.Title, "node-00 CPU") || cpu.Percent != 95 || !strings.Contains(cpu.Label, "use 95%") ||
+		!strings.Contains(cpu.Label, "lim 200%") {
+		t.Errorf("unexpected CPU gauge %q %d %q", cpu.Title, cpu.Percent, cpu.Label)
+	}
+	if gauges[1].Percent != 12 {
+		t.Errorf("expected memory gauge at 12%%, got %d", gauges[1].Percent)
+	}
+	for _, g := range gauges {
+		r := g.GetRect()
+		if r.Max.X > d.width || r.Max.Y > d.height || r.Min.X < dashSidebarMin {
+			t.Errorf("gauge %q outside the node area: %v", g.Title, r)
+		}
+	}
+}
+
+func TestDashboard_Paging(t *testing.T) {
+	d := newDashTestDashboard(50, false)
+	// 120x40: 80 columns for nodes (one per row), 37 rows of 3 cells.
+	if pages := d.pageCount(d.width, d.height); pages != 5 {
+		t.Fatalf("expected 5 pages, got %d", pages)
+	}
+	for _, key := range []string{"<Right>", "<PageDown>", "<End>", "<Right>"} {
+		if d.handleEvent(key) {
+			t.Fatalf("%s should not quit", key)
+		}
+	}
+	if d.page != 4 {
+		t.Errorf("expected the last page, got %d", d.page)
+	}
+	d.handleEvent("<Left>")
+	d.handleEvent("<Home>")
+	d.handleEvent("<PageUp>")
+	if d.page != 0 {
+		t.Errorf("expected the first page, got %d", d.page)
+	}
+
+	// A taller terminal needs fewer pages; the current page is clamped.
+	d.page = 4
+	d.width, d.height = 200, 80
+	d.widgets(d.width, d.height)
+	if pages := d.pageCount(d.width, d.height); pages != 1 || d.page != 0 {
+		t.Errorf("expected one page after resize, got %d pages, page %d", pages, d.page)
+	}
+
+	if !d.handleEvent("q") {
+		t.Error("expected q to quit")
+	}
+}
+
+func TestDashboard_PieAndNamespaces(t *testing.T) {
+	d := newDashTestDashboard(2, true)
+
+	var pies []*widgets.PieChart
+	var legend *widgets.Paragraph
+	for _, w := range d.widgets(d.width, d.height) {
+		switch w := w.(type) {
+		case *widgets.PieChart:
+			pies = append(pies, w)
+		case *widgets.Paragraph:
+			if strings.HasPrefix(w.Title, "Namespaces") {
+				legend = w
+			}
+		}
+	}
+	if len(pies) != 5 {
+		t.Fatalf("expected 4 node pies and a namespace pie, got %d", len(pies))
+	}
+	if legend == nil || !strings.Contains(legend.Text, "ns-7") || !strings.Contains(legend.Text, "(3 others)") {
+		t.Fatalf("unexpected namespace legend: %+v", legend)
+	}
+	if strings.Index(legend.Text, "ns-7") > strings.Index(legend.Text, "ns-6") {
+		t.Errorf("expected namespaces ordered by CPU requests:\n%s", legend.Text)
+	}
+
+	d.handleEvent("m")
+	slices := d.namespaceSlices()
+	if len(slices) != dashMaxSlices || slices[0].name != "ns-0" {
+		t.Errorf("expected memory ordering after toggling, got %+v", slices)
+	}
+}
+
+func TestDashNodePie(t *testing.T) {
+	data, _ := dashNodePie(dashFigures{requests: 50, limits: 80, usage: 20})
+	if len(data) != 3 || data[0] != 20 || data[1] != 30 || data[2] != 50 {
+		t.Errorf("unexpected slices %v", data)
+	}
+	data, _ = dashNodePie(dashFigures{requests: 50, usage: -1})
+	if data[0] != 0 || data[1] != 50 {
+		t.Errorf("expected requests without usage, got %v", data)
+	}
+	if data, _ = dashNodePie(dashFigures{usage: -1, requests: 0}); len(data) != 3 || data[2] != 100 {
+		t.Errorf("expected an idle node to be free, got %v", data)
+	}
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metricsV1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// exporterNamespace prefixes every exported metric name.
+const exporterNamespace = "glance"
+
+// exporterScrapeTimeout bounds the metrics source queries made per scrape.
+const exporterScrapeTimeout = 20 * time.Second
+
+// clusterCache is the read side of WatchCache used by the exporter.
+type clusterCache interface {
+	GetNodes() []v1.Node
+	GetPods() []v1.Pod
+}
+
+// resourceDescs are the request, limit and usage metrics exported for each
+// level of aggregation (node, namespace, workload and cluster).
+type resourceDescs struct {
+	pods        *prometheus.Desc
+	cpuRequests *prometheus.Desc
+	cpuLimits   *prometheus.Desc
+	cpuUsage    *prometheus.Desc
+	memRequests *prometheus.Desc
+	memLimits   *prometheus.Desc
+	memUsage    *prometheus.Desc
+}
+
+// resourceValues are the values reported through resourceDescs. Usage is
+// nil when unknown, in which case the usage series are not exported.
+type resourceValues struct {
+	pods        int
+	cpuRequests *resource.Quantity
+	cpuLimits   *resource.Quantity
+	cpuUsage    *resource.Quantity
+	memRequests *resource.Quantity
+	memLimits   *resource.Quantity
+	memUsage    *resource.Quantity
+}
+
+func newResourceDescs(subsystem, what string, labels ...string) resourceDescs {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(exporterNamespace, subsystem, name), help, labels, nil)
+	}
+	return resourceDescs{
+		pods:        desc("pods", "Number of non-terminated pods on the "+what+"."),
+		cpuRequests: desc("cpu_requests_cores", "Sum of container CPU requests on the "+what+"."),
+		cpuLimits:   desc("cpu_limits_cores", "Sum of container CPU limits on the "+what+"."),
+		cpuUsage:    desc("cpu_usage_cores", "CPU usage of the "+what+" reported by the metrics source."),
+		memRequests: desc("memory_requests_bytes", "Sum of container memory requests on the "+what+"."),
+		memLimits:   desc("memory_limits_bytes", "Sum of container memory limits on the "+what+"."),
+		memUsage:    desc("memory_usage_bytes", "Memory working set of the "+what+" reported by the metrics source."),
+	}
+}
+
+func (d resourceDescs) describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{d.pods, d.cpuRequests, d.cpuLimits, d.cpuUsage, d.memRequests, d.memLimits, d.memUsage} {
+		ch <- desc
+	}
+}
+
+func (d resourceDescs) collect(ch chan<- prometheus.Metric, v resourceValues, labelValues ...string) {
+	ch <- prometheus.MustNewConstMetric(d.pods, prometheus.GaugeValue, float64(v.pods), labelValues...)
+	ch <- prometheus.MustNewConstMetric(d.cpuRequests, prometheus.GaugeValue, cpuCores(v.cpuRequests), labelValues...)
+	ch <- prometheus.MustNewConstMetric(d.cpuLimits, prometheus.GaugeValue, cpuCores(v.cpuLimits), labelValues...)
+	ch <- prometheus.MustNewConstMetric(d.memRequests, prometheus.GaugeValue, quantityBytes(v.memRequests), labelValues...)
+	ch <- prometheus.MustNewConstMetric(d.memLimits, prometheus.GaugeValue, quantityBytes(v.memLimits), labelValues...)
+	if v.cpuUsage != nil {
+		ch <- prometheus.MustNewConstMetric(d.cpuUsage, prometheus.GaugeValue, cpuCores(v.cpuUsage), labelValues...)
+	}
+	if v.memUsage != nil {
+		ch <- prometheus.MustNewConstMetric(d.memUsage, prometheus.GaugeValue, quantityBytes(v.memUsage), labelValues...)
+	}
+}
+
+// capacityDescs are the allocatable, overcommit and pod-slot metrics
+// exported for nodes and the whole cluster.
+type capacityDescs struct {
+	allocatableCPU    *prometheus.Desc
+	allocatableMemory *prometheus.Desc
+	cpuOvercommit     *prometheus.Desc
+	memoryOvercommit  *prometheus.Desc
+	podCapacity       *prometheus.Desc
+}
+
+func newCapacityDescs(subsystem, what string, labels ...string) capacityDescs {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(exporterNamespace, subsystem, name), help, labels, nil)
+	}
+	return capacityDescs{
+		allocatableCPU:    desc("allocatable_cpu_cores", "Allocatable CPU of the "+what+"."),
+		allocatableMemory: desc("allocatable_memory_bytes", "Allocatable memory of the "+what+"."),
+		cpuOvercommit:     desc("cpu_overcommit_ratio", "CPU limits divided by allocatable CPU of the "+what+"."),
+		memoryOvercommit:  desc("memory_overcommit_ratio", "Memory limits divided by allocatable memory of the "+what+"."),
+		podCapacity:       desc("pod_capacity", "Allocatable pod slots of the "+what+"."),
+	}
+}
+
+func (d capacityDescs) describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{d.allocatableCPU, d.allocatableMemory, d.cpuOvercommit, d.memoryOvercommit, d.podCapacity} {
+		ch <- desc
+	}
+}
+
+func (d capacityDescs) collect(ch chan<- prometheus.Metric, cpu, memory, cpuLimits, memLimits *resource.Quantity,
+	podCapacity int64, labelValues ...string) {
+	ch <- prometheus.MustNewConstMetric(d.allocatableCPU, prometheus.GaugeValue, cpuCores(cpu), labelValues...)
+	ch <- prometheus.MustNewConstMetric(d.allocatableMemory, prometheus.GaugeValue, quantityBytes(memory), labelValues...)
+	if c := cpuCores(cpu); c > 0 {
+		ch <- prometheus.MustNewConstMetric(d.cpuOvercommit, prometheus.GaugeValue, cpuCores(cpuLimits)/c, labelValues...)
+	}
+	if m := quantityBytes(memory); m > 0 {
+		ch <- prometheus.MustNewConstMetric(d.memoryOvercommit, prometheus.GaugeValue, quantityBytes(memLimits)/m, labelValues...)
+	}
+	ch <- prometheus.MustNewConstMetric(d.podCapacity, prometheus.GaugeValue, float64(podCapacity), labelValues...)
+}
+
+// glanceCollector is a prometheus.Collector that computes the glance node,
+// namespace, workload and cluster figures from an informer cache on every
+// scrape, using the same pkg/core aggregation as the CLI.
+type glanceCollector struct {
+	cache   clusterCache
+	metrics metricsource.Source // nil with --metrics=off
+
+	node          resourceDescs
+	nodeCapacity  capacityDescs
+	nodeReady     *prometheus.Desc
+	namespace     resourceDescs
+	workload      resourceDescs
+	cluster       resourceDescs
+	clusterCap    capacityDescs
+	clusterNodes  *prometheus.Desc
+	pendingPods   *prometheus.Desc
+	pendingCPU    *prometheus.Desc
+	pendingMemory *prometheus.Desc
+	available     *prometheus.Desc
+}
+
+// newGlanceCollector returns a collector reading from cache and, when
+// metricsSource is non-nil, usage from the metrics source.
+func newGlanceCollector(cache clusterCache, metricsSource metricsource.Source) *glanceCollector {
+	nodeLabels := []string{"node", "node_group"}
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(exporterNamespace, "", name), help, labels, nil)
+	}
+
+	return &glanceCollector{
+		cache:         cache,
+		metrics:       metricsSource,
+		node:          newResourceDescs("node", "node", nodeLabels...),
+		nodeCapacity:  newCapacityDescs("node", "node", nodeLabels...),
+		nodeReady:     desc("node_ready", "1 if the node is Ready, 0 otherwise.", nodeLabels...),
+		namespace:     newResourceDescs("namespace", "namespace", "namespace"),
+		workload:      newResourceDescs("workload", "workload", "namespace", "kind", "workload"),
+		cluster:       newResourceDescs("cluster", "cluster (Ready nodes)"),
+		clusterCap:    newCapacityDescs("cluster", "cluster (Ready nodes)"),
+		clusterNodes:  desc("cluster_nodes", "Number of nodes by readiness.", "status"),
+		pendingPods:   desc("cluster_pending_pods", "Number of Pending pods not yet bound to a node."),
+		pendingCPU:    desc("cluster_pending_cpu_requests_cores", "CPU requests of unscheduled pods."),
+		pendingMemory: desc("cluster_pending_memory_requests_bytes", "Memory requests of unscheduled pods."),
+		available:     desc("metrics_available", "1 if usage metrics could be read on the last scrape, 0 otherwise."),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *glanceCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.node.describe(ch)
+	c.nodeCapacity.describe(ch)
+	ch <- c.nodeReady
+	c.namespace.describe(ch)
+	c.workload.describe(ch)
+	c.cluster.describe(ch)
+	c.clusterCap.describe(ch)
+	ch <- c.clusterNodes
+	ch <- c.pendingPods
+	ch <- c.pendingCPU
+	ch <- c.pendingMemory
+	ch <- c.available
+}
+
+// Collect implements prometheus.Collector.
+func (c *glanceCollector) Collect(ch chan<- prometheus.Metric) {
+	nodes := c.cache.GetNodes()
+	pods := c.cache.GetPods()
+
+	nodeMetrics, podMetrics, metricsAvailable := c.fetchUsage()
+
+	podsByNode, unscheduled := groupPodsByNode(pods)
+	nm, totals, err := core.ComputeNodeSnapshot(nodes, podsByNode, nodeMetrics, core.NodeSnapshotOptions{})
+	if err != nil {
+		log.Errorf("Failed to compute node snapshot: %v", err)
+		return
+	}
+	core.ApplyPendingDemand(&totals, unscheduled)
+
+	ready, notReady := 0, 0
+	podCapacity := resource.NewQuantity(0, resource.DecimalSI)
+	for i := range nodes {
+		node := &nodes[i]
+		stats := nm[node.Name]
+		group := extractNodeGroupFromLabels(node.Labels)
+		if stats == nil || stats.Status != "Ready" {
+			notReady++
+			ch <- prometheus.MustNewConstMetric(c.nodeReady, prometheus.GaugeValue, 0, node.Name, group)
+			continue
+		}
+		ready++
+		ch <- prometheus.MustNewConstMetric(c.nodeReady, prometheus.GaugeValue, 1, node.Name, group)
+
+		slots := node.Status.Allocatable.Pods()
+		podCapacity.Add(*slots)
+		c.nodeCapacity.collect(ch, stats.AllocatableCPU, stats.AllocatableMemory,
+			&stats.AllocatedCPULimits, &stats.AllocatedMemoryLimits, slots.Value(), node.Name, group)
+		c.node.collect(ch, resourceValues{
+			pods:        stats.PodCount,
+			cpuRequests: &stats.AllocatedCPUrequests,
+			cpuLimits:   &stats.AllocatedCPULimits,
+			cpuUsage:    stats.UsageCPU,
+			memRequests: &stats.AllocatedMemoryRequests,
+			memLimits:   &stats.AllocatedMemoryLimits,
+			memUsage:    stats.UsageMemory,
+		}, node.Name, group)
+	}
+
+	clusterPods := 0
+	for _, stats := range nm {
+		clusterPods += stats.PodCount
+	}
+	clusterUsage := resourceValues{
+		pods:        clusterPods,
+		cpuRequests: totals.TotalAllocatedCPUrequests,
+		cpuLimits:   totals.TotalAllocatedCPULimits,
+		memRequests: totals.TotalAllocatedMemoryRequests,
+		memLimits:   totals.TotalAllocatedMemoryLimits,
+	}
+	if metricsAvailable {
+		clusterUsage.cpuUsage = totals.TotalUsageCPU
+		clusterUsage.memUsage = totals.TotalUsageMemory
+	}
+	c.cluster.collect(ch, clusterUsage)
+	c.clusterCap.collect(ch, totals.TotalAllocatableCPU, totals.TotalAllocatableMemory,
+		totals.TotalAllocatedCPULimits, totals.TotalAllocatedMemoryLimits, podCapacity.Value())
+	ch <- prometheus.MustNewConstMetric(c.clusterNodes, prometheus.GaugeValue, float64(ready), "Ready")
+	ch <- prometheus.MustNewConstMetric(c.clusterNodes, prometheus.GaugeValue, float64(notReady), "NotReady")
+	ch <- prometheus.MustNewConstMetric(c.pendingPods, prometheus.GaugeValue, float64(totals.PendingPods))
+	ch <- prometheus.MustNewConstMetric(c.pendingCPU, prometheus.GaugeValue, cpuCores(totals.TotalPendingCPURequests))
+	ch <- prometheus.MustNewConstMetric(c.pendingMemory, prometheus.GaugeValue, quantityBytes(totals.TotalPendingMemoryRequests))
+	availableValue := 0.0
+	if metricsAvailable {
+		availableValue = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.available, prometheus.GaugeValue, availableValue)
+
+	namespaces := core.AggregatePods(pods, podMetrics, func(p *v1.Pod) string { return p.Namespace })
+	for ns, agg := range namespaces {
+		c.namespace.collect(ch, aggregateValues(agg, metricsAvailable), ns)
+	}
+
+	workloads := core.AggregatePods(pods, podMetrics, func(p *v1.Pod) string {
+		kind, name := core.WorkloadOf(p)
+		return p.Namespace + "/" + kind + "/" + name
+	})
+	for key, agg := range workloads {
+		parts := strings.SplitN(key, "/", 3)
+		c.workload.collect(ch, aggregateValues(agg, metricsAvailable), parts[0], parts[1], parts[2])
+	}
+}
+
+// fetchUsage reads node and pod usage from the metrics source. Usage is
+// reported as available only if both queries succeed.
+func (c *glanceCollector) fetchUsage() (
+	map[string]*metricsV1beta1api.NodeMetrics, map[string]*metricsV1beta1api.PodMetrics, bool) {
+	return fetchClusterUsage(c.metrics)
+}
+
+// fetchClusterUsage reads node and pod usage for the whole cluster from src,
+// which may be nil, within exporterScrapeTimeout.
+func fetchClusterUsage(src metricsource.Source) (
+	map[string]*metricsV1beta1api.NodeMetrics, map[string]*metricsV1beta1api.PodMetrics, bool) {
+	if src == nil {
+		return nil, nil, false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), exporterScrapeTimeout)
+	defer cancel()
+
+	nodeMetrics, err := src.NodeMetrics(ctx)
+	if err != nil {
+		log.Debugf("Failed to fetch node metrics from %s: %v", src.Name(), err)
+		return nil, nil, false
+	}
+	podMetrics, err := src.PodMetrics(ctx, "")
+	if err != nil {
+		log.Debugf("Failed to fetch pod metrics from %s: %v", src.Name(), err)
+		return nil, nil, false
+	}
+	return nodeMetrics, podMetrics, true
+}
+
+// aggregateValues converts a core.ResourceAggregate for export.
+func aggregateValues(agg *core.ResourceAggregate, usageKnown bool) resourceValues {
+	v := resourceValues{
+		pods:        agg.Pods,
+		cpuRequests: &agg.CPURequests,
+		cpuLimits:   &agg.CPULimits,
+		memRequests: &agg.MemoryRequests,
+		memLimits:   &agg.MemoryLimits,
+	}
+	if usageKnown {
+		v.cpuUsage = &agg.CPUUsage
+		v.memUsage = &agg.MemoryUsage
+	}
+	return v
+}
+
+// cpuCores converts a CPU quantity to cores; nil is zero.
+func cpuCores(q *resource.Quantity) float64 {
+	if q == nil {
+		return 0
+	}
+	return float64(q.MilliValue()) / 1000
+}
+
+// quantityBytes converts a memory quantity to bytes; nil is zero.
+func quantityBytes(q *resource.Quantity) float64 {
+	if q == nil {
+		return 0
+	}
+	return float64(q.Value())
+}
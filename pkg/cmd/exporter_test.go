@@ -0,0 +1,180 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// staticClusterCache is an in-memory clusterCache for tests.
+type staticClusterCache struct {
+	nodes []v1.Node
+	pods  []v1.Pod
+}
+
+func (c *staticClusterCache) GetNodes() []v1.Node { return c.nodes }
+func (c *staticClusterCache) GetPods() []v1.Pod   { return c.pods }
+
+func newExporterTestCache() *staticClusterCache {
+	controller := true
+	node := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"eks.amazonaws.com/nodegroup": "general"}},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("4"),
+				v1.ResourceMemory: resource.MustParse("8Gi"),
+				v1.ResourcePods:   resource.MustParse("110"),
+			},
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+		},
+	}
+	container := v1.Container{Name: "app", Resources: v1.ResourceRequirements{
+		Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Gi")},
+		Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), v1.ResourceMemory: resource.MustParse("2Gi")},
+	}}
+	pod := func(name string) v1.Pod {
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "payments",
+				Labels:          map[string]string{"pod-template-hash": "abc12"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "api-abc12", Controller: &controller}},
+			},
+			Spec:   v1.PodSpec{NodeName: "node-1", Containers: []v1.Container{container}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+	pending := pod("api-pending")
+	pending.Spec.NodeName = ""
+	pending.Status.Phase = v1.PodPending
+
+	return &staticClusterCache{
+		nodes: []v1.Node{node},
+		pods:  []v1.Pod{pod("api-1"), pod("api-2"), pending},
+	}
+}
+
+// gaugeValue returns the value of the series with the given name and labels.
+func gaugeValue(t *testing.T, reg *prometheus.Registry, name string, labels map[string]string) (float64, bool) {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("gather failed: %v", err)
+	}
+	for _, mf := range families {
+		if mf.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, m := range mf.GetMetric() {
+			for _, lp := range m.GetLabel() {
+				if want, ok := labels[lp.GetName()]; ok && want != lp.GetValue() {
+					continue metrics
+				}
+			}
+			return m.GetGauge().GetValue(), true
+		}
+	}
+	return 0, false
+}
+
+func TestGlanceCollector(t *testing.T) {
+	source := &stubMetricsSource{
+		nodes: map[string]*metricsv1beta1.NodeMetrics{
+			"node-1": {Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1500m"), v1.ResourceMemory: resource.MustParse("3Gi")}},
+		},
+		pods: map[string]*metricsv1beta1.PodMetrics{
+			metricsource.PodKey("payments", "api-1"): {Containers: []metricsv1beta1.ContainerMetrics{{
+				Name: "app", Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m")},
+			}}},
+		},
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(newGlanceCollector(newExporterTestCache(), source))
+
+	node := map[string]string{"node": "node-1", "node_group": "general"}
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   float64
+	}{
+		{"glance_node_allocatable_cpu_cores", node, 4},
+		{"glance_node_cpu_requests_cores", node, 1},
+		{"glance_node_cpu_usage_cores", node, 1.5},
+		{"glance_node_cpu_overcommit_ratio", node, 1},
+		{"glance_node_memory_limits_bytes", node, 4 * 1024 * 1024 * 1024},
+		{"glance_node_pods", node, 2},
+		{"glance_node_pod_capacity", node, 110},
+		{"glance_node_ready", node, 1},
+		{"glance_namespace_pods", map[string]string{"namespace": "payments"}, 3},
+		{"glance_namespace_cpu_usage_cores", map[string]string{"namespace": "payments"}, 0.25},
+		{"glance_workload_cpu_requests_cores", map[string]string{"kind": "Deployment", "workload": "api"}, 1.5},
+		{"glance_cluster_pending_pods", nil, 1},
+		{"glance_cluster_memory_usage_bytes", nil, 3 * 1024 * 1024 * 1024},
+		{"glance_metrics_available", nil, 1},
+	}
+	for _, tt := range tests {
+		got, ok := gaugeValue(t, reg, tt.name, tt.labels)
+		if !ok {
+			t.Errorf("%s%v: series not found", tt.name, tt.labels)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s%v = %v, want %v", tt.name, tt.labels, got, tt.want)
+		}
+	}
+}
+
+func TestGlanceCollector_MetricsUnavailable(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(newGlanceCollector(newExporterTestCache(), &stubMetricsSource{err: errors.New("not found")}))
+
+	if v, ok := gaugeValue(t, reg, "glance_metrics_available", nil); !ok || v != 0 {
+		t.Errorf("expected glance_metrics_available 0, got %v (found=%v)", v, ok)
+	}
+	if _, ok := gaugeValue(t, reg, "glance_node_cpu_usage_cores", nil); ok {
+		t.Error("expected no usage series when metrics are unavailable")
+	}
+	if v, ok := gaugeValue(t, reg, "glance_node_cpu_requests_cores", nil); !ok || v != 1 {
+		t.Errorf("expected allocation to still be exported, got %v", v)
+	}
+}
+
+func TestServeMux(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(newGlanceCollector(newExporterTestCache(), nil))
+	srv := httptest.NewServer(newServeMux(reg, nil))
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("GET /metrics failed: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), `glance_node_allocatable_cpu_cores{node="node-1",node_group="general"} 4`) {
+		t.Errorf("unexpected /metrics body:\n%s", body)
+	}
+}
@@ -0,0 +1,476 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pt "github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// fleetRequestTimeout bounds each cluster's API requests so an unreachable
+// cluster cannot hold up the whole fleet view.
+const fleetRequestTimeout = 30 * time.Second
+
+// fleetStatusOK is shown in the STATUS column for clusters read successfully.
+const fleetStatusOK = "OK"
+
+// fleetResult pairs a cluster's JSON snapshot with collection details used
+// by the text renderers.
+type fleetResult struct {
+	core.ClusterSnapshot
+	extendedUsage bool
+	clusterName   string
+}
+
+// fleetRequested reports whether --contexts or --all-contexts was given.
+func fleetRequested() bool {
+	return viper.GetBool("all-contexts") || len(viper.GetStringSlice("contexts")) > 0
+}
+
+// resolveFleetContexts returns the kubeconfig contexts to read. With all set
+// every context is returned in name order; otherwise the named contexts are
+// validated and returned in the order given, without duplicates.
+func resolveFleetContexts(raw clientcmdapi.Config, names []string, all bool) ([]string, error) {
+	if all && len(names) > 0 {
+		return nil, fmt.Errorf("--contexts and --all-contexts are mutually exclusive")
+	}
+
+	if all {
+		contexts := make([]string, 0, len(raw.Contexts))
+		for name := range raw.Contexts {
+			contexts = append(contexts, name)
+		}
+		if len(contexts) == 0 {
+			return nil, fmt.Errorf("no contexts found in kubeconfig")
+		}
+		sort.Strings(contexts)
+		return contexts, nil
+	}
+
+	seen := make(map[string]bool, len(names))
+	contexts := make([]string, 0, len(names))
+	var unknown []string
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		if _, ok := raw.Contexts[name]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		contexts = append(contexts, name)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown kubeconfig context(s): %s", strings.Join(unknown, ", "))
+	}
+	if len(contexts) == 0 {
+		return nil, fmt.Errorf("no contexts given")
+	}
+	return contexts, nil
+}
+
+// contextGlanceConfig returns a GlanceConfig that targets the named context
+// while keeping every other kubeconfig flag (kubeconfig path, namespace,
+// credentials, impersonation, TLS and server overrides) from the base flags.
+func contextGlanceConfig(base *GlanceConfig, contextName string) (*GlanceConfig, error) {
+	flags := copyConfigFlags(base.configFlags)
+	flags.Context = &contextName
+
+	rc, err := flags.ToRESTConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes config: %w", err)
+	}
+	if rc.Timeout == 0 {
+		rc.Timeout = fleetRequestTimeout
+	}
+
+	return &GlanceConfig{configFlags: flags, restConfig: rc, IOStreams: base.IOStreams}, nil
+}
+
+// copyConfigFlags returns new ConfigFlags with the flag values of base.
+// ConfigFlags holds locks and cached clients, so it cannot be copied as a
+// value; the copy builds its own clients from the same flags.
+func copyConfigFlags(base *genericclioptions.ConfigFlags) *genericclioptions.ConfigFlags {
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.CacheDir = base.CacheDir
+	flags.KubeConfig = base.KubeConfig
+	flags.ClusterName = base.ClusterName
+	flags.AuthInfoName = base.AuthInfoName
+	flags.Context = base.Context
+	flags.Namespace = base.Namespace
+	flags.APIServer = base.APIServer
+	flags.TLSServerName = base.TLSServerName
+	flags.Insecure = base.Insecure
+	flags.CertFile = base.CertFile
+	flags.KeyFile = base.KeyFile
+	flags.CAFile = base.CAFile
+	flags.BearerToken = base.BearerToken
+	flags.Impersonate = base.Impersonate
+	flags.ImpersonateUID = base.ImpersonateUID
+	flags.ImpersonateGroup = base.ImpersonateGroup
+	flags.Username = base.Username
+	flags.Password = base.Password
+	flags.Timeout = base.Timeout
+	flags.DisableCompression = base.DisableCompression
+	flags.WrapConfigFn = base.WrapConfigFn
+	return flags
+}
+
+// collectFleet runs collect for every context concurrently. A failing
+// cluster is recorded in its result and never affects the others. Results
+// are returned in the order of contexts.
+func collectFleet(ctx context.Context, contexts []string,
+	collect func(ctx context.Context, contextName string) (*clusterSnapshot, error)) []fleetResult {
+	results := make([]fleetResult, len(contexts))
+
+	var wg sync.WaitGroup
+	for i, name := range contexts {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			results[i].Context = name
+			snap, err := collect(ctx, name)
+			if err != nil {
+				log.Warnf("Context %s: %v", name, err)
+				results[i].Error = err.Error()
+				return
+			}
+			results[i].Snapshot = snap.Snapshot
+			results[i].extendedUsage = snap.extendedUsage
+			results[i].clusterName = snap.clusterName
+		}(i, name)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// GlanceFleet collects one snapshot per requested kubeconfig context and
+// renders the fleet view. It fails only when no cluster could be read.
+func GlanceFleet(gc *GlanceConfig) error {
+	raw, err := gc.configFlags.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	contexts, err := resolveFleetContexts(raw, viper.GetStringSlice("contexts"), viper.GetBool("all-contexts"))
+	if err != nil {
+		return err
+	}
+
+	results := collectFleet(context.Background(), contexts,
+		func(ctx context.Context, contextName string) (*clusterSnapshot, error) {
+			cgc, err := contextGlanceConfig(gc, contextName)
+			if err != nil {
+				return nil, err
+			}
+			k8sClient, err := kubernetes.NewForConfig(cgc.restConfig)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			return collectClusterSnapshot(ctx, k8sClient, cgc)
+		})
+
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+			continue
+		}
+		// Same auto-enable rules as the single-cluster view, applied once
+		// after collection so viper is never written concurrently.
+		if r.extendedUsage && !viper.GetBool("show-storage") {
+			viper.Set("show-storage", true)
+		}
+		gpu := r.Totals.TotalAllocatableGPU
+		if !viper.GetBool("show-gpu") && gpu != nil && !gpu.IsZero() {
+			viper.Set("show-gpu", true)
+		}
+	}
+
+	if err := renderFleet(results); err != nil {
+		return err
+	}
+
+	if failed == len(results) {
+		return fmt.Errorf("unable to read any of %d cluster(s)", len(results))
+	}
+	return nil
+}
+
+// renderFleet renders fleet results according to the global output format.
+// JSON output is a glance/v1 SnapshotList with one document per cluster;
+// clusters that could not be read carry metadata.error and no nodes.
+func renderFleet(results []fleetResult) error {
+	output := viper.GetString("output")
+	if isTemplateFormat(output) {
+		var rows []nodeRow
+		for _, r := range results {
+			if r.Error == "" {
+				rows = append(rows, nodeRows(r.Nodes, r.Context)...)
+			}
+		}
+		return renderTemplateOutput(output, rows)
+	}
+	switch output {
+	case outputFormatJSON:
+		b, err := json.MarshalIndent(fleetDocument(results), "", "\t")
+		if err != nil {
+			log.Errorf("failed to marshal fleet to JSON: %v", err)
+			return fmt.Errorf("failed to render fleet JSON output: %w", err)
+		}
+		fmt.Println(string(b))
+		return nil
+	case outputFormatYAML:
+		return renderYAML(fleetDocument(results), "fleet")
+	case outputFormatCSV, outputFormatMarkdown, outputFormatHTML, outputFormatChart, outputFormatDash, outputFormatPie:
+		return fmt.Errorf("output format %q is not supported with --contexts or --all-contexts; use -o json, -o yaml or a template format", output)
+	}
+
+	fleetSummaryTable(results, output == outputFormatPretty)
+	if viper.GetBool("fleet-nodes") {
+		fleetNodeTable(results, output == outputFormatPretty)
+	}
+	return nil
+}
+
+// fleetDocument returns the glance/v1 SnapshotList of the fleet, one item
+// per cluster; unreadable clusters carry their error in the metadata.
+func fleetDocument(results []fleetResult) core.SnapshotListDocument {
+	generated := time.Now().UTC().Truncate(time.Second)
+	docs := make([]core.SnapshotDocument, 0, len(results))
+	for _, r := range results {
+		docs = append(docs, core.NewSnapshotDocument(r.Snapshot, core.DocumentMetadata{
+			GeneratedAt: generated,
+			Context:     r.Context,
+			Cluster:     r.clusterName,
+			Error:       r.Error,
+		}))
+	}
+	return core.NewSnapshotListDocument(docs)
+}
+
+// addQuantity adds q to *sum, allocating the sum on first use.
+func addQuantity(sum **resource.Quantity, q *resource.Quantity) {
+	if q == nil {
+		return
+	}
+	if *sum == nil {
+		c := q.DeepCopy()
+		*sum = &c
+		return
+	}
+	(*sum).Add(*q)
+}
+
+// sumFleetTotals adds the totals of every readable cluster. Usage is summed
+// only over clusters that reported metrics, and the allocatable figures used
+// for the usage percentages are restricted to the same clusters.
+func sumFleetTotals(results []fleetResult) (sum core.Totals, usageCPUAlloc, usageMemAlloc *resource.Quantity, nodes int) {
+	for _, r := range results {
+		if r.Error != "" {
+			continue
+		}
+		t := r.Totals
+		nodes += len(r.Nodes)
+		addQuantity(&sum.TotalAllocatableCPU, t.TotalAllocatableCPU)
+		addQuantity(&sum.TotalAllocatableMemory, t.TotalAllocatableMemory)
+		addQuantity(&sum.TotalAllocatedCPUrequests, t.TotalAllocatedCPUrequests)
+		addQuantity(&sum.TotalAllocatedCPULimits, t.TotalAllocatedCPULimits)
+		addQuantity(&sum.TotalAllocatedMemoryRequests, t.TotalAllocatedMemoryRequests)
+		addQuantity(&sum.TotalAllocatedMemoryLimits, t.TotalAllocatedMemoryLimits)
+		sum.PendingPods += t.PendingPods
+		if t.MetricsAvailable {
+			sum.MetricsAvailable = true
+			addQuantity(&sum.TotalUsageCPU, t.TotalUsageCPU)
+			addQuantity(&sum.TotalUsageMemory, t.TotalUsageMemory)
+			addQuantity(&usageCPUAlloc, t.TotalAllocatableCPU)
+			addQuantity(&usageMemAlloc, t.TotalAllocatableMemory)
+		}
+	}
+	return sum, usageCPUAlloc, usageMemAlloc, nodes
+}
+
+// fleetPct formats used/alloc as a percentage, or "--" when unknown.
+func fleetPct(used, alloc *resource.Quantity, isMemory bool) string {
+	if used == nil || alloc == nil {
+		return "--"
+	}
+	if isMemory {
+		if alloc.Value() == 0 {
+			return "--"
+		}
+		return fmt.Sprintf("%.1f%%", float64(used.Value())/float64(alloc.Value())*100)
+	}
+	if alloc.MilliValue() == 0 {
+		return "--"
+	}
+	return fmt.Sprintf("%.1f%%", float64(used.MilliValue())/float64(alloc.MilliValue())*100)
+}
+
+// fleetTotalsCells returns the resource cells shared by cluster rows and the
+// fleet footer.
+func fleetTotalsCells(t *core.Totals, cpuAlloc, memAlloc *resource.Quantity) pt.Row {
+	cpuUse, cpuPct := formatQuantity(t.TotalUsageCPU), fleetPct(t.TotalUsageCPU, cpuAlloc, false)
+	memUse, memPct := formatQuantity(t.TotalUsageMemory), fleetPct(t.TotalUsageMemory, memAlloc, true)
+	if !t.MetricsAvailable {
+		cpuUse, cpuPct = usageNotAvailable, usageNotAvailable
+		memUse, memPct = usageNotAvailable, usageNotAvailable
+	}
+
+	return pt.Row{
+		formatQuantity(t.TotalAllocatableCPU),
+		formatQuantity(t.TotalAllocatedCPUrequests),
+		formatQuantity(t.TotalAllocatedCPULimits),
+		cpuUse,
+		cpuPct,
+		formatQuantity(t.TotalAllocatableMemory),
+		formatQuantity(t.TotalAllocatedMemoryRequests),
+		formatQuantity(t.TotalAllocatedMemoryLimits),
+		memUse,
+		memPct,
+		t.PendingPods,
+	}
+}
+
+// fleetSummaryTable prints one row per cluster with its totals, followed by
+// a FLEET footer summing every readable cluster.
+func fleetSummaryTable(results []fleetResult, pretty bool) {
+	t := pt.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	if pretty {
+		t.SetStyle(pt.StyleRounded)
+	} else {
+		t.SetStyle(pt.StyleLight)
+	}
+	// Keep quantity suffixes (Gi, Mi) intact in the footer.
+	t.Style().Format.Footer = text.FormatDefault
+
+	t.AppendHeader(pt.Row{
+		"CLUSTER", "STATUS", "VERSION", "NODES",
+		"CPU ALLOC", "CPU REQ", "CPU LIM", "CPU USE", "CPU %",
+		"MEM ALLOC", "MEM REQ", "MEM LIM", "MEM USE", "MEM %",
+		"PENDING",
+	})
+
+	var cols []pt.ColumnConfig
+	for i := 4; i <= 15; i++ {
+		cols = append(cols, pt.ColumnConfig{Number: i, Align: text.AlignRight, AlignFooter: text.AlignRight})
+	}
+	t.SetColumnConfigs(cols)
+
+	for _, r := range results {
+		if r.Error != "" {
+			row := pt.Row{r.Context, text.FgRed.Sprint("ERROR: " + r.Error)}
+			t.AppendRow(row)
+			continue
+		}
+		row := pt.Row{r.Context, fleetStatusOK, r.Totals.ClusterInfo.MasterVersion, len(r.Nodes)}
+		row = append(row, fleetTotalsCells(&r.Totals, r.Totals.TotalAllocatableCPU, r.Totals.TotalAllocatableMemory)...)
+		t.AppendRow(row)
+	}
+
+	sum, cpuAlloc, memAlloc, nodes := sumFleetTotals(results)
+	ok := 0
+	for _, r := range results {
+		if r.Error == "" {
+			ok++
+		}
+	}
+	footer := pt.Row{"FLEET", fmt.Sprintf("%d/%d ok", ok, len(results)), "", nodes}
+	footer = append(footer, fleetTotalsCells(&sum, cpuAlloc, memAlloc)...)
+	t.AppendFooter(footer)
+
+	fmt.Println()
+	t.Render()
+}
+
+// fleetNodeTable prints every node of every readable cluster, tagged with
+// the cluster (context) name.
+func fleetNodeTable(results []fleetResult, pretty bool) {
+	showVersion := true
+	if viper.IsSet("show-node-version") {
+		showVersion = viper.GetBool("show-node-version")
+	}
+	showAge := viper.GetBool("show-node-age")
+	showGroup := viper.GetBool("show-node-group")
+	showGPU := viper.GetBool("show-gpu")
+	showStorage := viper.GetBool("show-storage")
+
+	t := pt.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	if pretty {
+		t.SetStyle(pt.StyleRounded)
+	} else {
+		t.SetStyle(pt.StyleLight)
+	}
+
+	header := pt.Row{"CLUSTER", "NODE", "STATUS"}
+	if showVersion {
+		header = append(header, "VERSION")
+	}
+	if showAge {
+		header = append(header, "AGE")
+	}
+	if showGroup {
+		header = append(header, "GROUP")
+	}
+	header = append(header,
+		"CPU REQ", "CPU LIM", "CPU USE", "CPU %",
+		"MEM REQ", "MEM LIM", "MEM USE", "MEM %")
+	if showGPU {
+		header = append(header, "GPU REQ/ALLOC")
+	}
+	if showStorage {
+		header = append(header, storageHeaders...)
+	}
+	t.AppendHeader(header)
+
+	for _, r := range results {
+		if r.Error != "" {
+			continue
+		}
+		names := make([]string, 0, len(r.Nodes))
+		for name := range r.Nodes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			row := pt.Row{r.Context}
+			row = append(row, buildTableRow(name, r.Nodes[name], showVersion, showAge, showGroup, showGPU, showStorage, false)...)
+			t.AppendRow(row)
+		}
+	}
+
+	fmt.Println()
+	t.Render()
+}
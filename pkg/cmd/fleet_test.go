@@ -0,0 +1,210 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
+)
+
+func TestResolveFleetContexts(t *testing.T) {
+	raw := clientcmdapi.Config{Contexts: map[string]*clientcmdapi.Context{
+		"prod":    {Cluster: "prod"},
+		"staging": {Cluster: "staging"},
+		"dev":     {Cluster: "dev"},
+	}}
+
+	got, err := resolveFleetContexts(raw, nil, true)
+	if err != nil || !reflect.DeepEqual(got, []string{"dev", "prod", "staging"}) {
+		t.Errorf("all contexts: got %v, %v", got, err)
+	}
+
+	got, err = resolveFleetContexts(raw, []string{"staging", " prod", "staging"}, false)
+	if err != nil || !reflect.DeepEqual(got, []string{"staging", "prod"}) {
+		t.Errorf("named contexts: got %v, %v", got, err)
+	}
+
+	if _, err := resolveFleetContexts(raw, []string{"prod", "qa"}, false); err == nil || !strings.Contains(err.Error(), "qa") {
+		t.Errorf("expected unknown context error naming qa, got %v", err)
+	}
+	if _, err := resolveFleetContexts(raw, []string{"prod"}, true); err == nil {
+		t.Error("expected error when combining --contexts and --all-contexts")
+	}
+}
+
+// testClusterSnapshot returns a cluster of n Ready nodes with cpu and memory
+// allocatable each and, with metrics, 500m CPU usage per node.
+func testClusterSnapshot(cpu, memory string, n int, metrics bool) *clusterSnapshot {
+	nodes := make([]testNode, n)
+	for i := range nodes {
+		nodes[i] = testNode{name: fmt.Sprintf("node-%d", i), status: "Ready", cpu: cpu, memory: memory, cpuUsage: "500m"}
+	}
+	nm, totals := buildTestSnapshot(metrics, nodes...)
+	return &clusterSnapshot{Snapshot: core.NewSnapshot(*nm, *totals)}
+}
+
+func TestCollectFleet_FailureIsolated(t *testing.T) {
+	results := collectFleet(context.Background(), []string{"prod", "broken", "staging"},
+		func(_ context.Context, name string) (*clusterSnapshot, error) {
+			if name == "broken" {
+				return nil, fmt.Errorf("connection refused")
+			}
+			return testClusterSnapshot("2", "4Gi", 2, true), nil
+		})
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for i, want := range []string{"prod", "broken", "staging"} {
+		if results[i].Context != want {
+			t.Errorf("result %d: expected context %s, got %s", i, want, results[i].Context)
+		}
+	}
+	if results[1].Error != "connection refused" {
+		t.Errorf("expected broken cluster error to be recorded, got %q", results[1].Error)
+	}
+	if results[0].Error != "" || len(results[2].Nodes) != 2 {
+		t.Errorf("expected healthy clusters to be unaffected, got %+v", results)
+	}
+}
+
+func TestRenderFleet(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	results := collectFleet(context.Background(), []string{"prod", "staging", "broken"},
+		func(_ context.Context, name string) (*clusterSnapshot, error) {
+			switch name {
+			case "prod":
+				return testClusterSnapshot("2", "4Gi", 2, true), nil
+			case "staging":
+				return testClusterSnapshot("2", "4Gi", 1, false), nil
+			}
+			return nil, fmt.Errorf("timeout")
+		})
+
+	viper.Set("fleet-nodes", true)
+	out := captureOutput(func() {
+		if err := renderFleet(results); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	for _, want := range []string{"CLUSTER", "prod", "staging", "ERROR: timeout", "FLEET", "2/3 ok", "25.0%", usageNotAvailable} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected fleet output to contain %q, got:\n%s", want, out)
+		}
+	}
+	// Per-node rows are tagged with their cluster.
+	if strings.Count(out, "node-0") != 2 {
+		t.Errorf("expected node-0 from both clusters, got:\n%s", out)
+	}
+
+	viper.Set("output", outputFormatJSON)
+	out = captureOutput(func() {
+		if err := renderFleet(results); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	var list core.SnapshotListDocument
+	if err := json.Unmarshal([]byte(out), &list); err != nil {
+		t.Fatalf("expected JSON list, got %v:\n%s", err, out)
+	}
+	if list.APIVersion != core.DocumentAPIVersion || list.Kind != core.KindSnapshotList || len(list.Items) != 3 {
+		t.Fatalf("unexpected fleet JSON: %+v", list)
+	}
+	if list.Items[0].Metadata.Context != "prod" || list.Items[2].Metadata.Error != "timeout" {
+		t.Errorf("unexpected fleet item metadata: %+v", list.Items)
+	}
+	if len(list.Items[0].Nodes) == 0 || len(list.Items[2].Nodes) != 0 {
+		t.Errorf("expected nodes only for readable clusters, got %+v", list.Items)
+	}
+
+	viper.Set("output", outputFormatYAML)
+	out = captureOutput(func() {
+		if err := renderFleet(results); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	list = core.SnapshotListDocument{}
+	if err := yaml.Unmarshal([]byte(out), &list); err != nil {
+		t.Fatalf("expected YAML list, got %v:\n%s", err, out)
+	}
+	if list.Kind != core.KindSnapshotList || len(list.Items) != 3 || list.Items[1].Metadata.Context != "staging" {
+		t.Errorf("unexpected fleet YAML: %+v", list)
+	}
+
+	for _, output := range []string{outputFormatCSV, outputFormatHTML, outputFormatChart, outputFormatDash} {
+		viper.Set("output", output)
+		if err := renderFleet(results); err == nil || !strings.Contains(err.Error(), "not supported with --contexts") {
+			t.Errorf("-o %s: expected an unsupported format error, got %v", output, err)
+		}
+	}
+}
+
+func TestContextGlanceConfigKeepsFlags(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: staging
+  cluster: {server: https://staging.example.com}
+- name: prod
+  cluster: {server: https://prod.example.com}
+users:
+- name: dev
+  user: {token: from-kubeconfig}
+contexts:
+- name: staging
+  context: {cluster: staging, user: dev}
+- name: prod
+  context: {cluster: prod, user: dev}
+current-context: staging
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	flags := genericclioptions.NewConfigFlags(true)
+	token, user, groups := "from-flag", "alice", []string{"admins"}
+	flags.KubeConfig = &kubeconfig
+	flags.BearerToken = &token
+	flags.Impersonate = &user
+	flags.ImpersonateGroup = &groups
+
+	gc, err := contextGlanceConfig(&GlanceConfig{configFlags: flags}, "prod")
+	if err != nil {
+		t.Fatalf("contextGlanceConfig() error = %v", err)
+	}
+	rc := gc.restConfig
+	if rc.Host != "https://prod.example.com" {
+		t.Errorf("Host = %q, want the prod cluster", rc.Host)
+	}
+	if rc.BearerToken != token || rc.Impersonate.UserName != user || !reflect.DeepEqual(rc.Impersonate.Groups, groups) {
+		t.Errorf("credentials not kept: token %q, impersonate %+v", rc.BearerToken, rc.Impersonate)
+	}
+	if *flags.Context != "" {
+		t.Errorf("base --context changed to %q", *flags.Context)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"gitlab.com/davidxarnold/glance/pkg/cloud"
 	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
 	glanceutil "gitlab.com/davidxarnold/glance/pkg/util"
 	v "gitlab.com/davidxarnold/glance/version"
 	v1 "k8s.io/api/core/v1"
@@ -231,7 +232,8 @@ func setupGlanceFlags(cmd *cobra.Command, labelSelector, fieldSelector, output *
 		"Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	cmd.PersistentFlags().StringVarP(
 		output, "output", "o", "pretty",
-		"Output format. One of: txt|pretty|json|dash|pie|chart")
+		"Output format. One of: txt|pretty|json|yaml|csv|markdown|html|dash|pie|chart|"+
+			"custom-columns=...|jsonpath=...|go-template=...")
 	cmd.PersistentFlags().BoolVarP(
 		cloudInfo, "show-cloud-provider", "c", false,
 		"-c, --show-cloud-provider  Display cloud provider metadata (AWS/GCP instance types, regions).\n"+
@@ -253,12 +255,34 @@ func setupGlanceFlags(cmd *cobra.Command, labelSelector, fieldSelector, output *
 	cmd.PersistentFlags().BoolVar(&showGPU, "show-gpu", false,
 		"Show GPU resource columns. Auto-enabled when GPU nodes are detected.")
 
+	// Storage/network column visibility flag
+	var showStorage bool
+	cmd.PersistentFlags().BoolVar(&showStorage, "show-storage", false,
+		"Show ephemeral storage, filesystem and network columns. Auto-enabled when the metrics source reports them (kubelet).")
+
 	// Add --raw and --exact flags (aliases)
 	var showRaw bool
 	var exactValues bool
 	cmd.PersistentFlags().BoolVar(&showRaw, "raw", false, "Show raw Kubernetes resource values (e.g., 1500m, 2048Mi)")
 	cmd.PersistentFlags().BoolVar(&exactValues, "exact", false, "Alias for --raw")
 
+	// Metrics source flags. metrics-server remains the default; Prometheus
+	// can provide averages or percentiles over a window and the kubelet
+	// Summary API works without any add-on.
+	var metricsSource, prometheusURL, metricsAggregation string
+	var metricsWindow time.Duration
+	cmd.PersistentFlags().StringVar(&metricsSource, "metrics-source", metricsource.SourceMetricsServer,
+		"Where to read usage metrics from. One of: metrics-server|prometheus|kubelet")
+	cmd.PersistentFlags().StringVar(&prometheusURL, "prometheus-url", "",
+		"Prometheus server URL used with --metrics-source=prometheus (e.g. http://prometheus.monitoring:9090)")
+	cmd.PersistentFlags().DurationVar(&metricsWindow, "metrics-window", time.Hour,
+		"Window to aggregate usage over when --metrics-aggregation is avg or p95 (Prometheus only)")
+	cmd.PersistentFlags().StringVar(&metricsAggregation, "metrics-aggregation", string(metricsource.AggregationLatest),
+		"How to aggregate usage over --metrics-window. One of: latest|avg|p95 (Prometheus only)")
+	var metricsMode string
+	cmd.PersistentFlags().StringVar(&metricsMode, "metrics", metricsModeAuto,
+		"Usage metrics mode. One of: auto|required|off. auto and off show allocation-only output when usage is unavailable")
+
 	cobra.OnInitialize(initConfig)
 
 	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
@@ -272,8 +296,14 @@ func setupGlanceFlags(cmd *cobra.Command, labelSelector, fieldSelector, output *
 	_ = viper.BindPFlag("show-node-age", cmd.PersistentFlags().Lookup("show-node-age"))
 	_ = viper.BindPFlag("show-node-group", cmd.PersistentFlags().Lookup("show-node-group"))
 	_ = viper.BindPFlag("show-gpu", cmd.PersistentFlags().Lookup("show-gpu"))
+	_ = viper.BindPFlag("show-storage", cmd.PersistentFlags().Lookup("show-storage"))
 	_ = viper.BindPFlag("show-raw", cmd.PersistentFlags().Lookup("raw"))
 	_ = viper.BindPFlag("exact", cmd.PersistentFlags().Lookup("exact"))
+	_ = viper.BindPFlag("metrics-source", cmd.PersistentFlags().Lookup("metrics-source"))
+	_ = viper.BindPFlag("prometheus-url", cmd.PersistentFlags().Lookup("prometheus-url"))
+	_ = viper.BindPFlag("metrics-window", cmd.PersistentFlags().Lookup("metrics-window"))
+	_ = viper.BindPFlag("metrics-aggregation", cmd.PersistentFlags().Lookup("metrics-aggregation"))
+	_ = viper.BindPFlag("metrics", cmd.PersistentFlags().Lookup("metrics"))
 	_ = viper.BindPFlags(cmd.Flags())
 }
 
@@ -317,6 +347,15 @@ func NewGlanceCmd() *cobra.Command {
 			viper.Set("show-raw", showRaw)
 			viper.Set("exact", showRaw)
 
+			// --contexts/--all-contexts: one snapshot per cluster.
+			if fleetRequested() {
+				if err := GlanceFleet(gc); err != nil {
+					fmt.Fprintln(os.Stderr, err.Error())
+					return err
+				}
+				return nil
+			}
+
 			// create the clientset
 			// We resolve the REST config here to ensure flags (like --context) are respected
 			rc, err := gc.configFlags.ToRESTConfig()
@@ -346,6 +385,12 @@ func NewGlanceCmd() *cobra.Command {
 
 	setupGlanceFlags(cmd, &labelSelector, &fieldSelector, &output, &cloudInfo)
 
+	// Multi-cluster (fleet) flags apply to the static root view only.
+	cmd.Flags().StringSlice("contexts", nil,
+		"Comma-separated kubeconfig contexts to show side by side as a fleet summary")
+	cmd.Flags().Bool("all-contexts", false, "Show a fleet summary of every kubeconfig context")
+	cmd.Flags().Bool("fleet-nodes", false, "With --contexts/--all-contexts, also list every node tagged with its cluster")
+
 	// Add Kubernetes config flags (Context, Kubeconfig, Namespace, etc.) to the command
 	gc.configFlags.AddFlags(cmd.PersistentFlags())
 
@@ -358,29 +403,93 @@ func NewGlanceCmd() *cobra.Command {
 	cmd.AddCommand(NewLiveCmd(gc))
 	cmd.AddCommand(NewPodsCmd(gc))
 	cmd.AddCommand(NewDeploymentsCmd(gc))
+	cmd.AddCommand(NewPendingCmd(gc))
+	cmd.AddCommand(NewOOMCmd(gc))
+	cmd.AddCommand(NewCompareCmd(gc))
+	cmd.AddCommand(NewServeCmd(gc))
+	cmd.AddCommand(NewCheckCmd(gc))
+	cmd.AddCommand(NewSchemaCmd())
 
 	return cmd
 }
 
 // GlanceK8s displays cluster information for a given clientset
 // GlanceK8s performs the core glance operation on a Kubernetes cluster.
-// nolint gocyclo
 func GlanceK8s(k8sClient *kubernetes.Clientset, gc *GlanceConfig) (err error) {
 	ctx := context.Background()
 
+	snap, err := collectClusterSnapshot(ctx, k8sClient, gc)
+	if err != nil {
+		return err
+	}
+	nm, totals := snap.Nodes, snap.Totals
+
+	// Storage and network usage is only available from some backends.
+	if snap.extendedUsage && !viper.GetBool("show-storage") {
+		viper.Set("show-storage", true)
+		log.Debug("Storage and network usage available, auto-enabling --show-storage")
+	}
+
+	// Auto-detect GPU resources: if any node has allocatable GPUs, enable
+	// the GPU column unless the user explicitly set --show-gpu=false.
+	if !viper.GetBool("show-gpu") && totals.TotalAllocatableGPU != nil && !totals.TotalAllocatableGPU.IsZero() {
+		viper.Set("show-gpu", true)
+		log.Debug("GPU resources detected, auto-enabling --show-gpu")
+	}
+
+	if err := render(&nm, &totals, snap.documentMetadata(), snap.namespaces); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// clusterSnapshot is a collected snapshot plus collection details the
+// renderers need but that are not part of the JSON document.
+type clusterSnapshot struct {
+	core.Snapshot
+	extendedUsage bool   // storage/network columns were populated
+	contextName   string // kubeconfig context and cluster, for document metadata
+	clusterName   string
+	// namespaces is only collected for outputs that show a namespace
+	// breakdown.
+	namespaces *namespaceBreakdown
+}
+
+// namespaceBreakdown aggregates pods by namespace for the cluster-level
+// charts.
+type namespaceBreakdown struct {
+	groups     map[string]*core.ResourceAggregate
+	usageKnown bool // pod usage could be read
+}
+
+// documentMetadata returns the glance/v1 document metadata of the snapshot.
+func (s *clusterSnapshot) documentMetadata() core.DocumentMetadata {
+	return core.DocumentMetadata{
+		GeneratedAt: time.Now().UTC().Truncate(time.Second),
+		Context:     s.contextName,
+		Cluster:     s.clusterName,
+	}
+}
+
+// collectClusterSnapshot gathers nodes, pods and metrics for one cluster and
+// aggregates them. It does not render and does not change viper settings, so
+// it is safe to call for several clusters concurrently.
+// nolint gocyclo
+func collectClusterSnapshot(ctx context.Context, k8sClient *kubernetes.Clientset, gc *GlanceConfig) (*clusterSnapshot, error) {
 	nodes, err := getNodes(ctx, k8sClient)
 	if err != nil {
-		return fmt.Errorf("error getting Node list from host: %w", err)
+		return nil, fmt.Errorf("error getting Node list from host: %w", err)
 	}
 
 	if len(nodes.Items) == 0 {
 		clusterName := getClusterName(gc)
-		return fmt.Errorf("%s: No Nodes found", clusterName)
+		return nil, fmt.Errorf("%s: No Nodes found", clusterName)
 	}
 
 	k8sver, err := k8sClient.Discovery().ServerVersion()
 	if err != nil {
-		return fmt.Errorf("failed to get server version: %w", err)
+		return nil, fmt.Errorf("failed to get server version: %w", err)
 	}
 
 	// Respect the explicit --show-cloud-provider flag or config value as-is.
@@ -389,47 +498,58 @@ func GlanceK8s(k8sClient *kubernetes.Clientset, gc *GlanceConfig) (err error) {
 
 	metricsClientset, err := metricsclientset.NewForConfig(gc.restConfig)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	metricsMode, metricsSource, err := resolveMetricsSource(gc)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build pod and metrics maps using list+group patterns similar to live mode.
-	podsByNode, err := buildNonTerminatedPodsByNode(ctx, k8sClient)
+	podsByNode, unscheduledPods, err := buildNonTerminatedPodsByNode(ctx, k8sClient)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Require metrics-server; fail with a clear message if metrics API is missing.
-	snapshotOpts := core.NodeSnapshotOptions{RequireMetrics: true}
+	// With --metrics=required, fail with a clear message if usage is
+	// missing; otherwise fall back to allocation-only output.
+	snapshotOpts := core.NodeSnapshotOptions{RequireMetrics: metricsMode == metricsModeRequired}
 
-	nodeMetricsByName, err := buildNodeMetricsByName(ctx, metricsClientset)
-	if err != nil {
-		if isMetricsServerNotAvailable(err) {
-			msg := "metrics-server (metrics.k8s.io) is required for glance to operate. Install the Kubernetes metrics-server add-on or your cloud provider's metrics extension."
-			log.Warnf("%s: %v", msg, err)
-			return fmt.Errorf("%s", msg)
+	var nodeMetricsByName map[string]*metricsV1beta1api.NodeMetrics
+	metricsAvailable := false
+	if metricsSource != nil {
+		nodeMetricsByName, err = metricsSource.NodeMetrics(ctx)
+		if err != nil {
+			if metricsMode == metricsModeRequired {
+				return nil, metricsRequiredError(metricsSource, err)
+			}
+			log.Warnf("Usage metrics unavailable from %s, showing allocation only: %v", metricsSource.Name(), err)
+		} else {
+			metricsAvailable = true
 		}
-		return err
 	}
 
 	// Compute core snapshot (NodeMap + Totals) using shared aggregation logic.
 	nm, totals, err := core.ComputeNodeSnapshot(nodes.Items, podsByNode, nodeMetricsByName, snapshotOpts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	totals.MetricsAvailable = metricsAvailable
+
+	// Record demand that the scheduler has not yet placed.
+	core.ApplyPendingDemand(&totals, unscheduledPods)
+
+	// Storage and network usage is only available from some backends.
+	extendedUsage := applyExtendedNodeUsage(ctx, metricsSource, nm)
+
 	// Set cluster info for display in summary
 	totals.ClusterInfo = core.ClusterInfo{
 		Host:          gc.restConfig.Host,
 		MasterVersion: k8sver.GitVersion,
 	}
 
-	// Auto-detect GPU resources: if any node has allocatable GPUs, enable
-	// the GPU column unless the user explicitly set --show-gpu=false.
-	if !viper.GetBool("show-gpu") && totals.TotalAllocatableGPU != nil && !totals.TotalAllocatableGPU.IsZero() {
-		viper.Set("show-gpu", true)
-		log.Debug("GPU resources detected, auto-enabling --show-gpu")
-	}
-
 	// If requested, enrich with pod-level details (reusing existing helper).
 	labelSelector := labels.Everything()
 	ls := viper.GetString("selector")
@@ -438,7 +558,7 @@ func GlanceK8s(k8sClient *kubernetes.Clientset, gc *GlanceConfig) (err error) {
 	if fs != "" || ls != "" {
 		labelSelector, err = labels.Parse(ls + " " + fs)
 		if err != nil {
-			return fmt.Errorf("invalid label/field selector: %w", err)
+			return nil, fmt.Errorf("invalid label/field selector: %w", err)
 		}
 	}
 
@@ -468,11 +588,61 @@ func GlanceK8s(k8sClient *kubernetes.Clientset, gc *GlanceConfig) (err error) {
 		cloudWg.Wait()
 	}
 
-	if err := render(&nm, &totals); err != nil {
-		return err
+	var namespaces *namespaceBreakdown
+	if outputShowsNamespaces(viper.GetString("output")) {
+		namespaces = collectNamespaceAggregates(ctx, metricsSource, metricsAvailable, podsByNode, unscheduledPods)
 	}
 
-	return nil
+	contextName, clusterName := getContextAndCluster(gc)
+	return &clusterSnapshot{
+		Snapshot:      core.NewSnapshot(nm, totals),
+		extendedUsage: extendedUsage,
+		contextName:   contextName,
+		clusterName:   clusterName,
+		namespaces:    namespaces,
+	}, nil
+}
+
+// outputShowsNamespaces reports whether the node view output format
+// includes a breakdown by namespace.
+func outputShowsNamespaces(output string) bool {
+	switch output {
+	case outputFormatChart, outputFormatDash, outputFormatPie:
+		return true
+	}
+	return false
+}
+
+// collectNamespaceAggregates sums requests, limits and usage of the listed
+// pods by namespace. Pod usage is fetched only when node usage was
+// available; if that fails the breakdown shows allocation only.
+func collectNamespaceAggregates(
+	ctx context.Context,
+	src metricsource.Source,
+	metricsAvailable bool,
+	podsByNode map[string][]v1.Pod,
+	unscheduled []v1.Pod,
+) *namespaceBreakdown {
+	pods := append([]v1.Pod(nil), unscheduled...)
+	for _, nodePods := range podsByNode {
+		pods = append(pods, nodePods...)
+	}
+
+	var podMetrics map[string]*metricsV1beta1api.PodMetrics
+	usageKnown := false
+	if metricsAvailable && src != nil {
+		var err error
+		podMetrics, err = src.PodMetrics(ctx, "")
+		if err != nil {
+			log.Warnf("Pod usage unavailable from %s, namespace breakdown shows allocation only: %v", src.Name(), err)
+		} else {
+			usageKnown = true
+		}
+	}
+	return &namespaceBreakdown{
+		groups:     core.AggregatePods(pods, podMetrics, func(p *v1.Pod) string { return p.Namespace }),
+		usageKnown: usageKnown,
+	}
 }
 
 func getNodes(ctx context.Context, clientset *kubernetes.Clientset) (nodes *v1.NodeList, err error) {
@@ -487,47 +657,157 @@ func getNodes(ctx context.Context, clientset *kubernetes.Clientset) (nodes *v1.N
 
 // buildNonTerminatedPodsByNode fetches all non-terminated pods once and groups
 // them by node name. This mirrors the live path's list+group pattern and
-// avoids per-node pod list calls.
-func buildNonTerminatedPodsByNode(ctx context.Context, clientset *kubernetes.Clientset) (map[string][]v1.Pod, error) {
-	podsByNode := make(map[string][]v1.Pod)
-
+// avoids per-node pod list calls. Pods not yet bound to a node are returned
+// separately so callers can report pending demand.
+func buildNonTerminatedPodsByNode(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+) (podsByNode map[string][]v1.Pod, unscheduled []v1.Pod, err error) {
 	podList, err := clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
 		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	for _, pod := range podList.Items {
+	podsByNode, unscheduled = groupPodsByNode(podList.Items)
+	return podsByNode, unscheduled, nil
+}
+
+// groupPodsByNode groups non-terminated pods by node name, returning pods
+// not yet bound to a node separately.
+func groupPodsByNode(pods []v1.Pod) (podsByNode map[string][]v1.Pod, unscheduled []v1.Pod) {
+	podsByNode = make(map[string][]v1.Pod)
+	for _, pod := range pods {
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
 		if pod.Spec.NodeName == "" {
+			unscheduled = append(unscheduled, pod)
 			continue
 		}
 		podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
 	}
+	return podsByNode, unscheduled
+}
+
+// applyExtendedNodeUsage copies storage and network usage onto nm when src
+// implements metricsource.ExtendedSource. It reports whether any node
+// received data. Failures are logged and leave the columns empty.
+func applyExtendedNodeUsage(ctx context.Context, src metricsource.Source, nm core.NodeMap) bool {
+	ext, ok := src.(metricsource.ExtendedSource)
+	if !ok {
+		return false
+	}
+	usage, err := ext.NodeExtendedUsage(ctx)
+	if err != nil {
+		log.Warnf("Failed to read storage and network usage from %s: %v", src.Name(), err)
+		return false
+	}
 
-	return podsByNode, nil
+	applied := false
+	for name, u := range usage {
+		ns, ok := nm[name]
+		if !ok {
+			continue
+		}
+		ns.UsageEphemeralStorage = u.EphemeralStorage
+		ns.FSUsed = u.FSUsed
+		ns.FSCapacity = u.FSCapacity
+		ns.NetworkRxBytes = u.NetworkRxBytes
+		ns.NetworkTxBytes = u.NetworkTxBytes
+		applied = true
+	}
+	return applied
 }
 
-// buildNodeMetricsByName lists node metrics once and returns a map keyed by
-// node name. Callers can decide how strictly to enforce metrics presence.
-func buildNodeMetricsByName(
-	ctx context.Context,
-	metricsClient *metricsclientset.Clientset,
-) (map[string]*metricsV1beta1api.NodeMetrics, error) {
-	metricsList, err := metricsClient.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{
-		ResourceVersion: "0",
-	})
+// Usage metrics modes selected with --metrics.
+const (
+	metricsModeAuto     = "auto"
+	metricsModeRequired = "required"
+	metricsModeOff      = "off"
+)
+
+// resolveMetricsSource validates --metrics and builds the metrics backend.
+// The returned source is nil when metrics are turned off.
+func resolveMetricsSource(gc *GlanceConfig) (string, metricsource.Source, error) {
+	mode := strings.ToLower(viper.GetString("metrics"))
+	switch mode {
+	case "":
+		mode = metricsModeAuto
+	case metricsModeAuto, metricsModeRequired:
+	case metricsModeOff:
+		return mode, nil, nil
+	default:
+		return "", nil, fmt.Errorf("unknown metrics mode %q (expected %s, %s or %s)",
+			mode, metricsModeAuto, metricsModeRequired, metricsModeOff)
+	}
+
+	src, err := newMetricsSource(gc)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
+	return mode, src, nil
+}
 
-	result := make(map[string]*metricsV1beta1api.NodeMetrics, len(metricsList.Items))
-	for i := range metricsList.Items {
-		m := &metricsList.Items[i]
-		result[m.Name] = m
+// metricsRequiredError explains a usage metrics failure under
+// --metrics=required.
+func metricsRequiredError(src metricsource.Source, err error) error {
+	if src.Name() == metricsource.SourceMetricsServer && isMetricsServerNotAvailable(err) {
+		msg := "metrics-server (metrics.k8s.io) is required with --metrics=required. " +
+			"Install the Kubernetes metrics-server add-on or your cloud provider's metrics extension, " +
+			"or use --metrics=auto for allocation-only output."
+		log.Warnf("%s: %v", msg, err)
+		return fmt.Errorf("%s", msg)
+	}
+	return fmt.Errorf("failed to read usage metrics from %s: %w", src.Name(), err)
+}
+
+// requirePodMetrics fails under --metrics=required when pod usage could not
+// be read. CollectPodStats itself treats missing metrics as non-fatal.
+func requirePodMetrics(mode string, src metricsource.Source, rows []PodSummaryRow) error {
+	if mode != metricsModeRequired || len(rows) == 0 || rows[0].MetricsAvailable {
+		return nil
 	}
+	return fmt.Errorf("pod usage metrics unavailable from %s (--metrics=required); "+
+		"use --metrics=auto for allocation-only output", src.Name())
+}
 
-	return result, nil
+// newMetricsSource builds the usage metrics backend selected by
+// --metrics-source. gc.restConfig must already be resolved.
+func newMetricsSource(gc *GlanceConfig) (metricsource.Source, error) {
+	switch name := viper.GetString("metrics-source"); name {
+	case "", metricsource.SourceMetricsServer:
+		client, err := metricsclientset.NewForConfig(gc.restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create metrics client: %w", err)
+		}
+		return metricsource.NewMetricsServer(client), nil
+	case metricsource.SourcePrometheus:
+		agg, err := metricsource.ParseAggregation(viper.GetString("metrics-aggregation"))
+		if err != nil {
+			return nil, err
+		}
+		return metricsource.NewPrometheus(viper.GetString("prometheus-url"), metricsource.PrometheusOptions{
+			Window:      viper.GetDuration("metrics-window"),
+			Aggregation: agg,
+		})
+	case metricsource.SourceKubelet:
+		client, err := kubernetes.NewForConfig(gc.restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create kubernetes client: %w", err)
+		}
+		// The live view binds --max-concurrent; static commands use the
+		// same default.
+		maxConcurrent := viper.GetInt("max-concurrent")
+		if maxConcurrent <= 0 {
+			maxConcurrent = defaultMaxConcurrent
+		}
+		return metricsource.NewKubeletSummary(client, maxConcurrent), nil
+	default:
+		return nil, fmt.Errorf("unknown metrics source %q (expected %s, %s or %s)",
+			name, metricsource.SourceMetricsServer, metricsource.SourcePrometheus, metricsource.SourceKubelet)
+	}
 }
 
 func getPodsInfo(
@@ -684,6 +964,9 @@ func getClusterName(gc *GlanceConfig) string {
 	}
 
 	ctxName := rawConfig.CurrentContext
+	if gc.configFlags.Context != nil && *gc.configFlags.Context != "" {
+		ctxName = *gc.configFlags.Context
+	}
 	if ctx, ok := rawConfig.Contexts[ctxName]; ok && ctx.Cluster != "" {
 		return ctx.Cluster
 	}
@@ -28,14 +28,13 @@ import (
 	"github.com/spf13/viper"
 	"gitlab.com/davidxarnold/glance/pkg/cloud"
 	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
 	glanceutil "gitlab.com/davidxarnold/glance/pkg/util"
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	metricsV1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
-	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
 // ViewMode represents the current display mode
@@ -46,6 +45,9 @@ const (
 	ViewPods
 	ViewNodes
 	ViewDeployments
+	ViewPending
+	ViewNodeDetail // pods and metadata of one node, opened from ViewNodes
+	ViewPodDetail  // containers and events of one pod, opened from ViewPods
 )
 
 const (
@@ -73,9 +75,10 @@ const (
 	thresholdHigh     = 90.0
 	thresholdCritical = 100.0
 
-	// Default limits for large cluster support
-	defaultNodeLimit      = 20
-	defaultPodLimit       = 100
+	// Default limits for large cluster support; 0 shows every row and the
+	// table scrolls instead
+	defaultNodeLimit      = 0
+	defaultPodLimit       = 0
 	defaultMaxConcurrent  = 50
 	largeClusterThreshold = 100
 )
@@ -100,6 +103,8 @@ type ResourceMetrics struct {
 	MemLimit    float64
 	MemUsage    float64
 	MemCapacity float64
+	// UsageUnknown suppresses usage bars when metrics are unavailable.
+	UsageUnknown bool
 }
 
 // LiveState holds the state for the live TUI
@@ -117,12 +122,19 @@ type LiveState struct {
 	compactMode            bool
 	showRawResources       bool   // Toggle between ratio format and raw resource values
 	showGPU                bool   // Toggle GPU resource columns
+	showStorage            bool   // Storage/network columns (kubelet source)
 	showCloudInfo          bool   // Toggle cloud provider information display
 	showNodeVersion        bool   // Toggle node version display
 	showNodeAge            bool   // Toggle node age display
 	showNodeGroup          bool   // Toggle node group/pool display
 	filterNodeGroup        string // Filter by node group/pool (empty = all)
 	filterCapacityType     string // Filter by capacity type: on-demand, spot, fargate (empty = all)
+	// Filter expression typed after "/", applied to every view
+	filterText    string
+	filter        *liveFilter // nil when filterText is empty or invalid
+	filterErr     error
+	filterEditing bool
+	filterDirty   bool // filter edited since the rows were last fetched
 	// Scaling options
 	nodeLimit     int
 	podLimit      int
@@ -132,8 +144,44 @@ type LiveState struct {
 	totalPods     int
 	// Namespace list for navigation
 	namespaceList []string
+	// Table scrolling: the first visible data row of each view, the
+	// selected data row of views without their own selection index, the
+	// data rows of the last render and how many of them fit on screen; windowEnd
+	// and rowLines (table lines per data row) map mouse clicks to data rows
+	scrollOffsets map[ViewMode]int
+	selectedRows  map[ViewMode]int
+	rowCount      int
+	pageSize      int
+	windowEnd     int
+	rowLines      int
+	// Pod selection and per-container expansion (Pods view)
+	selectedPodIndex int
+	podKeys          []string        // namespace/name of displayed pods, in row order
+	podRowIndex      []int           // data row index of each displayed pod (container rows are interleaved)
+	expandedPods     map[string]bool // namespace/name -> show container rows
+	// Node selection and drill-down (Nodes view)
+	selectedNodeIndex int
+	nodeKeys          []string          // names of displayed nodes, in row order
+	detailNode        string            // node shown in ViewNodeDetail
+	nodeSnapshot      *liveNodeSnapshot // nodes and pods of the last Nodes refresh
+	// Pod drill-down (Pods view)
+	detailPod string // namespace/name shown in ViewPodDetail
+	// Panels of the node and pod detail screens
+	detailHeader []string // lines above the table
+	detailFooter []string // lines below the table (recent pod events)
 	// Cloud info caching
 	cloudCache *cloud.Cache
+	// Key bindings from ~/.glance/config; nil uses the defaults
+	keys *liveKeyMap
+	// Informer cache of nodes, pods and namespaces (--watch); nil lists
+	// from the API server on every refresh.
+	cache *WatchCache
+	// Usage metrics backend (metrics-server, Prometheus, ...)
+	metricsSource metricsource.Source
+	// metricsMode is the --metrics mode; metricsAvailable records whether
+	// the last refresh could read usage.
+	metricsMode      string
+	metricsAvailable bool
 	// Derived context/cloud metadata for summary header
 	contextName   string
 	cloudProvider string
@@ -169,6 +217,7 @@ func NewLiveCmd(gc *GlanceConfig) *cobra.Command {
 	var podLimit int
 	var maxConcurrent int
 	var sortBy string
+	var watch bool
 
 	cmd := &cobra.Command{
 		Use:   "live",
@@ -178,19 +227,34 @@ func NewLiveCmd(gc *GlanceConfig) *cobra.Command {
 View modes:
   - Nodes (default): Shows node capacity, allocation, and usage
   - Namespaces: Shows resource requests, limits, and usage per namespace (navigate with ↑↓, Enter to view)
-  - Pods: Shows resource requests, limits, and usage per pod (namespace-scoped)
+  - Pods: Shows resource requests, limits, and usage per pod (namespace-scoped);
+    press ↑↓ to select a pod and 'e' to expand its per-container breakdown
+
+Every table scrolls: ↑↓ move the selection, PgUp/PgDn move a page and
+Home/End jump to the first or last row; the status bar shows "Rows X–Y of Z".
   - Deployments: Shows deployment resource requests and replica status
+  - Pending: Shows unscheduled pods with their requests, age and scheduling failure reason
 
 Scaling options:
-  - Use --node-limit to limit displayed nodes (default: 20)
-  - Use --pod-limit to limit displayed pods (default: 100)
+  - Use --node-limit to cap displayed nodes (default: 0, all nodes)
+  - Use --pod-limit to cap displayed pods (default: 0, all pods)
   - Use --sort-by to sort by status, cpu, memory, or name (default: status)
 
 Namespace navigation:
   - In Namespaces view: Press ↑↓ to select, Enter to view pods in that namespace
-  - In Pods/Deployments views: Press ←→ to cycle through namespaces
+  - In Pods/Deployments/Pending views: Press ←→ to cycle through namespaces
   - Use -n/--namespace flag to set initial namespace (default: all namespaces)
 
+Watch mode:
+  - Use --watch to keep nodes, pods and namespaces in informer caches instead of
+    listing them on every refresh; changes redraw the view at most once per
+    refresh interval and the interval only polls usage metrics
+
+Cluster switching:
+  - Press C to pick a kubeconfig context; it opens in a new tab (or focuses its tab)
+  - Press Tab to cycle tabs and X to close the current tab
+  - Each tab keeps its own view, namespace, sort order and cloud cache
+
 Controls will be displayed at the bottom of the screen.`,
 		SilenceErrors: true,
 		SilenceUsage:  true,
@@ -262,19 +326,22 @@ Controls will be displayed at the bottom of the screen.`,
 			// I'll stick with respecting the resolved namespace.
 
 			return runLive(k8sClient, gc, time.Duration(refreshInterval)*time.Second,
-				nodeLimit, podLimit, maxConcurrent, sortMode, namespace)
+				nodeLimit, podLimit, maxConcurrent, sortMode, namespace, watch)
 		},
 	}
 
 	cmd.Flags().IntVarP(&refreshInterval, "refresh", "r", 2, "Refresh interval in seconds")
 	cmd.Flags().IntVar(&nodeLimit, "node-limit", defaultNodeLimit,
-		"Maximum nodes to display (0 for unlimited)")
+		"Maximum nodes to display (0 for unlimited; the table scrolls)")
 	cmd.Flags().IntVar(&podLimit, "pod-limit", defaultPodLimit,
-		"Maximum pods to display per view (0 for unlimited)")
+		"Maximum pods to display per view (0 for unlimited; the table scrolls)")
 	cmd.Flags().IntVar(&maxConcurrent, "max-concurrent", defaultMaxConcurrent,
 		"Maximum concurrent API requests")
+	_ = viper.BindPFlag("max-concurrent", cmd.Flags().Lookup("max-concurrent"))
 	cmd.Flags().StringVar(&sortBy, "sort-by", sortByStatus,
 		"Sort by: status, name, cpu, memory")
+	cmd.Flags().BoolVar(&watch, "watch", false,
+		"Watch nodes, pods and namespaces with informers instead of listing them every refresh")
 
 	return cmd
 }
@@ -286,6 +353,7 @@ func runLive(
 	nodeLimit, podLimit, maxConcurrent int,
 	sortMode SortMode,
 	initialNamespace string,
+	watch bool,
 ) error {
 	// Ensure configuration is initialized so that live view honors
 	// settings from ~/.glance/config (e.g., show-node-version, show-node-age,
@@ -295,12 +363,159 @@ func runLive(
 		initConfig()
 	}
 
+	// Resolve the metrics backend before taking over the terminal so that
+	// configuration errors are printed normally.
+	metricsMode, metricsSource, err := resolveMetricsSource(gc)
+	if err != nil {
+		return err
+	}
+	notifier, err := newNotifier()
+	if err != nil {
+		return err
+	}
+	keys, err := loadLiveKeyMap()
+	if err != nil {
+		return err
+	}
+
+	state := newLiveState(refreshInterval, nodeLimit, podLimit, maxConcurrent, sortMode,
+		initialNamespace, metricsSource, metricsMode)
+	state.keys = keys
+	if watch {
+		if state.cache, err = startLiveWatch(k8sClient); err != nil {
+			return err
+		}
+	}
+
 	if err := ui.Init(); err != nil {
 		return fmt.Errorf("failed to initialize termui: %w", err)
 	}
 	defer ui.Close()
 
-	state := &LiveState{
+	detectLiveCluster(k8sClient, gc, state)
+
+	// Initialize UI components; they are shared by every cluster tab.
+	state.table = widgets.NewTable()
+	state.statusBar = widgets.NewParagraph()
+	state.menuBar = widgets.NewParagraph()
+
+	state.menuBar.Border = false
+	state.menuBar.WrapText = false // menu items are clicked by column
+	// Without a border, use the full height for the two menu lines.
+	state.menuBar.PaddingTop, state.menuBar.PaddingBottom = -1, -1
+	state.menuBar.Text = menuText(liveMenu, state.keyMap())
+	state.menuBar.TextStyle = ui.NewStyle(ui.ColorYellow)
+
+	// New tabs start from the display settings of the tab they were opened from.
+	var tabs *liveTabs
+	tabs = newLiveTabs(&liveTab{contextName: state.contextName, client: k8sClient, gc: gc, state: state},
+		func(contextName string) (*liveTab, error) {
+			return openLiveTab(gc, contextName, tabs.current().state)
+		})
+	tabs.contexts = kubeconfigContexts(gc)
+	defer tabs.stop()
+
+	// Webhook notifications follow the cluster live was started against.
+	// Without --watch the notifier lists nodes and pods on its own interval,
+	// independently of the view's refreshes.
+	if notifier != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		lister := apiLister(k8sClient)
+		if state.cache != nil {
+			lister = cacheLister(state.cache)
+		}
+		go runNotifications(ctx, notifier, state.contextName, lister, metricsSource)
+	}
+
+	// Initial render
+	if err := tabs.updateDisplay(); err != nil {
+		return err
+	}
+
+	// Set up event handling
+	uiEvents := ui.PollEvents()
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	// Under --watch, cache changes redraw the active tab at most once per
+	// refresh interval; debounce is nil while no redraw is pending.
+	var debounce <-chan time.Time
+	// filterDelay is set while filter edits wait for typing to pause.
+	var filterDelay <-chan time.Time
+	lastRender := time.Now()
+	refresh := func() {
+		tabs.current().state.lastUpdate = time.Now()
+		if err := tabs.updateDisplay(); err != nil {
+			log.Errorf("Failed to update display: %v", err)
+		}
+		lastRender = time.Now()
+		debounce = nil
+	}
+
+	for {
+		select {
+		case e := <-uiEvents:
+			if e.Type == ui.MouseEvent {
+				// Clicks and the wheel act as the matching key, if any.
+				key, redraw := handleMouseEvent(e, tabs.current().state, tabs.showPicker)
+				if key == "" {
+					if redraw {
+						if err := tabs.updateDisplay(); err != nil {
+							log.Errorf("Failed to update display: %v", err)
+						}
+					}
+					continue
+				}
+				e = ui.Event{Type: ui.KeyboardEvent, ID: key}
+			}
+			if tabs.handleEvent(e) {
+				continue
+			}
+			cur := tabs.current()
+			if handleUIEvent(e, cur.client, cur.gc, cur.state) {
+				return nil
+			}
+			if cur.state.filterDirty {
+				filterDelay = time.After(liveFilterDelay)
+			}
+
+		case <-filterDelay:
+			filterDelay = nil
+			if tabs.current().state.filterDirty {
+				if err := tabs.updateDisplay(); err != nil {
+					log.Errorf("Failed to update display: %v", err)
+				}
+			}
+
+		case <-tabs.current().state.cacheUpdates():
+			if debounce == nil {
+				debounce = time.After(time.Until(lastRender.Add(refreshInterval)))
+			}
+
+		case <-debounce:
+			refresh()
+			ticker.Reset(refreshInterval)
+
+		case <-ticker.C:
+			// Nodes and pods come from the informer cache under --watch,
+			// so the tick only polls usage metrics.
+			refresh()
+		}
+	}
+}
+
+// newLiveState returns the initial live view state for one cluster, with
+// display settings taken from flags and ~/.glance/config.
+func newLiveState(
+	refreshInterval time.Duration,
+	nodeLimit, podLimit, maxConcurrent int,
+	sortMode SortMode,
+	initialNamespace string,
+	metricsSource metricsource.Source,
+	metricsMode string,
+) *LiveState {
+	return &LiveState{
 		mode:                   ViewNodes,
 		selectedNamespace:      initialNamespace,
 		selectedNamespaceIndex: 0,
@@ -315,6 +530,7 @@ func runLive(
 		sortMode:               sortMode,
 		cloudCache:             cloud.NewCache(viper.GetDuration("cloud-cache-ttl"), viper.GetBool("cloud-cache-disk")),
 		showGPU:                viper.GetBool("show-gpu"),
+		showStorage:            viper.GetBool("show-storage"),
 		showCloudInfo:          viper.GetBool("show-cloud-provider"),
 		showNodeVersion:        viper.GetBool("show-node-version"),
 		showNodeAge:            viper.GetBool("show-node-age"),
@@ -326,24 +542,26 @@ func runLive(
 		modalScrollOffset:      0,
 		modalDirty:             false,
 		showConfirmDiscard:     false,
+		expandedPods:           make(map[string]bool),
+		metricsSource:          metricsSource,
+		metricsMode:            metricsMode,
 	}
+}
 
-	// Check cluster size and warn for large clusters
-	// Also detect if cloud provider is present
-	ctx := context.Background()
-	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{
-		ResourceVersion: "0", // Use watch cache for faster response
-	})
+// detectLiveCluster records cluster size, cloud provider and kubeconfig
+// context names in state, warning about large clusters.
+func detectLiveCluster(k8sClient *kubernetes.Clientset, gc *GlanceConfig, state *LiveState) {
+	nodes, err := state.listNodes(context.Background(), k8sClient)
 	hasCloudProvider := false
 	if err == nil {
-		state.totalNodes = len(nodes.Items)
-		if state.totalNodes > largeClusterThreshold {
-			log.Warnf("Large cluster detected (%d nodes). Using --node-limit=%d for performance. "+
+		state.totalNodes = len(nodes)
+		if state.totalNodes > largeClusterThreshold && state.cache == nil {
+			log.Warnf("Large cluster detected (%d nodes). "+
 				"Consider using --watch mode for real-time updates with lower API load.",
-				state.totalNodes, nodeLimit)
+				state.totalNodes)
 		}
 		// Check if any node has a cloud provider ID
-		for _, node := range nodes.Items {
+		for _, node := range nodes {
 			if node.Spec.ProviderID != "" {
 				cp, _ := glanceutil.ParseProviderID(node.Spec.ProviderID)
 				if cp == providerAWS || cp == providerGCE {
@@ -362,46 +580,15 @@ func runLive(
 	if !viper.IsSet("show-cloud-provider") && !hasCloudProvider {
 		state.showCloudInfo = false
 	}
-
-	// Initialize UI components
-	state.table = widgets.NewTable()
-	state.statusBar = widgets.NewParagraph()
-	state.menuBar = widgets.NewParagraph()
-
-	state.menuBar.Border = false
-	state.menuBar.Text = " Views: [o]Nodes [n]Namespaces [p]Pods [d]Deployments | " +
-		"Toggle: [b]Bars [%]Percent [r]Raw [u]GPU [w]Cloud [v]Version [a]Age [g]Group\n" +
-		" Sort: [1]Status [2]Name [3]CPU [4]Memory | [?]Settings [q]Quit"
-	state.menuBar.TextStyle = ui.NewStyle(ui.ColorYellow)
-
-	// Initial render
-	if err := updateDisplay(k8sClient, gc, state); err != nil {
-		return err
-	}
-
-	// Set up event handling
-	uiEvents := ui.PollEvents()
-	ticker := time.NewTicker(refreshInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case e := <-uiEvents:
-			if handleUIEvent(e, k8sClient, gc, state) {
-				return nil
-			}
-
-		case <-ticker.C:
-			state.lastUpdate = time.Now()
-			if err := updateDisplay(k8sClient, gc, state); err != nil {
-				log.Errorf("Failed to update display: %v", err)
-			}
-		}
-	}
 }
 
 // handleUIEvent processes UI events and returns true if the app should exit.
 func handleUIEvent(e ui.Event, k8sClient *kubernetes.Clientset, gc *GlanceConfig, state *LiveState) bool {
+	// Ctrl-C quits whatever is open
+	if e.ID == interruptKey {
+		return true
+	}
+
 	// Handle confirmation dialog first if active
 	if state.showConfirmDiscard {
 		handleConfirmEvent(e, state)
@@ -418,11 +605,28 @@ func handleUIEvent(e ui.Event, k8sClient *kubernetes.Clientset, gc *GlanceConfig
 		return false
 	}
 
-	// Main UI event handling
-	switch e.ID {
-	case "q", "<C-c>":
+	// Handle the filter input if active. Keys only redraw the input; the
+	// rows are refetched with the new filter once typing pauses (see
+	// liveFilterDelay), or at once when the input is closed.
+	if state.filterEditing {
+		handleFilterEvent(e, state)
+		if state.filterEditing {
+			state.filterDirty = true
+			state.statusBar.Text = filterInputText(state)
+			ui.Render(state.statusBar)
+			return false
+		}
+		if err := updateDisplay(k8sClient, gc, state); err != nil {
+			log.Errorf("Failed to update display: %v", err)
+		}
+		return false
+	}
+
+	// Main UI event handling, by the action bound to the key
+	switch state.keyMap().action(e.ID) {
+	case actionQuit:
 		return true
-	case "?", "h":
+	case actionSettings:
 		// Open settings modal
 		initPendingState(state)
 		state.showSettingsModal = true
@@ -431,84 +635,87 @@ func handleUIEvent(e ui.Event, k8sClient *kubernetes.Clientset, gc *GlanceConfig
 		// Render modal immediately without fetching data
 		updateModalDisplay(state)
 		return false
-	case "n":
+	case actionViewNamespaces:
 		state.mode = ViewNamespaces
 		state.selectedNamespace = ""
 		state.selectedNamespaceIndex = 0
-	case "p":
+	case actionViewPods:
 		state.mode = ViewPods
-	case "o":
+	case actionViewNodes:
 		state.mode = ViewNodes
-	case "d":
+	case actionViewDeployments:
 		state.mode = ViewDeployments
-	case "b":
+	case actionViewPending:
+		state.mode = ViewPending
+	case actionToggleBars:
 		state.showBars = !state.showBars
 		viper.Set("show-bars", state.showBars)
 		writeConfigSafe()
-	case "%":
+	case actionTogglePercentages:
 		state.showPercentages = !state.showPercentages
 		viper.Set("show-percentages", state.showPercentages)
 		writeConfigSafe()
-	case "c":
+	case actionToggleCompact:
 		state.compactMode = !state.compactMode
 		viper.Set("compact-mode", state.compactMode)
 		writeConfigSafe()
-	case "r":
+	case actionToggleRaw:
 		state.showRawResources = !state.showRawResources
 		viper.Set("show-raw-resources", state.showRawResources)
 		writeConfigSafe()
-	case "u":
+	case actionToggleGPU:
 		state.showGPU = !state.showGPU
 		viper.Set("show-gpu", state.showGPU)
 		writeConfigSafe()
-	case "w":
+	case actionToggleCloud:
 		state.showCloudInfo = !state.showCloudInfo
 		viper.Set("show-cloud-provider", state.showCloudInfo)
 		writeConfigSafe()
-	case "v":
+	case actionToggleVersion:
 		state.showNodeVersion = !state.showNodeVersion
 		viper.Set("show-node-version", state.showNodeVersion)
 		writeConfigSafe()
-	case "a":
+	case actionToggleAge:
 		state.showNodeAge = !state.showNodeAge
 		viper.Set("show-node-age", state.showNodeAge)
 		writeConfigSafe()
-	case "g":
+	case actionToggleGroup:
 		state.showNodeGroup = !state.showNodeGroup
 		viper.Set("show-node-group", state.showNodeGroup)
 		writeConfigSafe()
-	case "1":
-		state.sortMode = SortByStatus
-		viper.Set("sort-by", "status")
-		writeConfigSafe()
-	case "2":
-		state.sortMode = SortByName
-		viper.Set("sort-by", "name")
-		writeConfigSafe()
-	case "3":
-		state.sortMode = SortByCPU
-		viper.Set("sort-by", "cpu")
-		writeConfigSafe()
-	case "4":
-		state.sortMode = SortByMemory
-		viper.Set("sort-by", "memory")
-		writeConfigSafe()
-	case "<Up>":
+	case actionSortStatus:
+		setSortMode(state, SortByStatus)
+	case actionSortName:
+		setSortMode(state, SortByName)
+	case actionSortCPU:
+		setSortMode(state, SortByCPU)
+	case actionSortMemory:
+		setSortMode(state, SortByMemory)
+	case actionExpand:
+		togglePodExpansion(state)
+	case actionUp:
 		handleUpArrow(state)
-	case "<Down>":
+	case actionDown:
 		handleDownArrow(state)
-	case "<Enter>":
+	case actionPageUp:
+		state.moveSelection(-state.page())
+	case actionPageDown:
+		state.moveSelection(state.page())
+	case actionHome:
+		state.setSelection(0)
+	case actionEnd:
+		_, count := state.selection()
+		state.setSelection(count - 1)
+	case actionDetails:
 		handleEnterKey(state)
-	case "<Left>":
+	case actionBack:
+		handleEscapeKey(state)
+	case actionFilter:
+		state.filterEditing = true
+	case actionLeft:
 		handleLeftArrow(k8sClient, state)
-	case "<Right>":
+	case actionRight:
 		handleRightArrow(k8sClient, state)
-	case "<Resize>":
-		// Handle terminal resize - update modal if open
-		if state.showSettingsModal {
-			updateModalDisplay(state)
-			return false
-		}
 	}
 
 	if err := updateDisplay(k8sClient, gc, state); err != nil {
@@ -517,43 +724,75 @@ func handleUIEvent(e ui.Event, k8sClient *kubernetes.Clientset, gc *GlanceConfig
 	return false
 }
 
-// handleUpArrow handles up arrow key navigation in namespace view.
+// handleUpArrow moves the selection of the current view up one row.
 func handleUpArrow(state *LiveState) {
-	if state.mode == ViewNamespaces && len(state.namespaceList) > 0 {
-		if state.selectedNamespaceIndex > 0 {
-			state.selectedNamespaceIndex--
-		}
-	}
+	state.moveSelection(-1)
 }
 
-// handleDownArrow handles down arrow key navigation in namespace view.
+// handleDownArrow moves the selection of the current view down one row.
 func handleDownArrow(state *LiveState) {
-	if state.mode == ViewNamespaces && len(state.namespaceList) > 0 {
-		if state.selectedNamespaceIndex < len(state.namespaceList)-1 {
-			state.selectedNamespaceIndex++
-		}
+	state.moveSelection(1)
+}
+
+// togglePodExpansion expands or collapses the per-container rows of the
+// selected pod in the Pods view.
+func togglePodExpansion(state *LiveState) {
+	if state.mode != ViewPods || state.selectedPodIndex >= len(state.podKeys) {
+		return
+	}
+	if state.expandedPods == nil {
+		state.expandedPods = make(map[string]bool)
+	}
+	key := state.podKeys[state.selectedPodIndex]
+	if state.expandedPods[key] {
+		delete(state.expandedPods, key)
+	} else {
+		state.expandedPods[key] = true
 	}
 }
 
-// handleEnterKey handles enter key to select namespace and switch to pods view.
+// handleEnterKey handles enter key to select namespace and switch to pods
+// view, or to open the detail screen of the selected node or pod.
 func handleEnterKey(state *LiveState) {
-	if state.mode == ViewNamespaces && len(state.namespaceList) > 0 {
+	switch {
+	case state.mode == ViewNamespaces && len(state.namespaceList) > 0:
 		state.selectedNamespace = state.namespaceList[state.selectedNamespaceIndex]
 		state.mode = ViewPods
+	case state.mode == ViewNodes && state.selectedNodeIndex < len(state.nodeKeys):
+		state.detailNode = state.nodeKeys[state.selectedNodeIndex]
+		state.mode = ViewNodeDetail
+	case state.mode == ViewPods && state.selectedPodIndex < len(state.podKeys):
+		state.detailPod = state.podKeys[state.selectedPodIndex]
+		state.mode = ViewPodDetail
+	}
+}
+
+// handleEscapeKey handles escape key to return from a detail screen, or
+// to clear the filter in the other views.
+func handleEscapeKey(state *LiveState) {
+	switch state.mode {
+	case ViewNodeDetail:
+		state.mode = ViewNodes
+		state.detailNode = ""
+	case ViewPodDetail:
+		state.mode = ViewPods
+		state.detailPod = ""
+	default:
+		state.setFilter("")
 	}
 }
 
 // handleLeftArrow handles left arrow key to cycle to previous namespace.
 func handleLeftArrow(k8sClient *kubernetes.Clientset, state *LiveState) {
-	if state.mode == ViewPods || state.mode == ViewDeployments {
-		state.selectedNamespace = getPreviousNamespace(k8sClient, state.selectedNamespace)
+	if state.mode == ViewPods || state.mode == ViewDeployments || state.mode == ViewPending {
+		state.selectedNamespace = getPreviousNamespace(k8sClient, state)
 	}
 }
 
 // handleRightArrow handles right arrow key to cycle to next namespace.
 func handleRightArrow(k8sClient *kubernetes.Clientset, state *LiveState) {
-	if state.mode == ViewPods || state.mode == ViewDeployments {
-		state.selectedNamespace = getNextNamespace(k8sClient, state.selectedNamespace)
+	if state.mode == ViewPods || state.mode == ViewDeployments || state.mode == ViewPending {
+		state.selectedNamespace = getNextNamespace(k8sClient, state)
 	}
 }
 
@@ -567,6 +806,7 @@ func min(a, b int) int {
 
 func updateDisplay(k8sClient *kubernetes.Clientset, gc *GlanceConfig, state *LiveState) error {
 	termWidth, termHeight := ui.TerminalDimensions()
+	state.filterDirty = false
 
 	var data [][]string
 	var header []string
@@ -582,14 +822,58 @@ func updateDisplay(k8sClient *kubernetes.Clientset, gc *GlanceConfig, state *Liv
 		header, data, metrics, err = fetchPodData(ctx, k8sClient, gc, state.selectedNamespace, state)
 	case ViewNodes:
 		header, data, metrics, err = fetchNodeData(ctx, k8sClient, gc, state)
+	case ViewNodeDetail:
+		header, data, metrics, err = fetchNodeDetailData(ctx, k8sClient, gc, state)
+	case ViewPodDetail:
+		header, data, metrics, err = fetchPodDetailData(ctx, k8sClient, gc, state)
 	case ViewDeployments:
-		header, data, metrics, err = fetchDeploymentData(ctx, k8sClient, state.selectedNamespace)
+		header, data, metrics, err = fetchDeploymentData(ctx, k8sClient, state.selectedNamespace, state)
+	case ViewPending:
+		header, data, metrics, err = fetchPendingData(ctx, k8sClient, state.selectedNamespace, state)
 	}
 
 	if err != nil {
 		return err
 	}
 
+	// Calculate summary stats over every row, not only the visible ones
+	summaryStats := calculateSummaryStats(metrics)
+
+	// Calculate table height based on compact mode (leave room for summary and shortcuts)
+	summaryHeight := 3
+	shortcutsHeight := 2                                            // Changed from 1 to 2 for two-line menu
+	tableHeight := termHeight - 4 - summaryHeight - shortcutsHeight // 4 = status bar (1) + borders (3)
+	if state.compactMode {
+		tableHeight = termHeight - 4 - shortcutsHeight
+		summaryHeight = 0
+	}
+	footerHeight := 0
+	if isDetailMode(state.mode) {
+		// The detail header replaces the summary, even in compact mode,
+		// and the footer takes the bottom of the table area.
+		summaryHeight = len(state.detailHeader) + 2
+		if len(state.detailFooter) > 0 {
+			footerHeight = len(state.detailFooter) + 2
+		}
+		tableHeight = termHeight - 4 - summaryHeight - shortcutsHeight
+	}
+
+	// Show the window of data rows that fits between the table borders
+	// and header; each data row takes two lines when bars are drawn.
+	rowMultiplier := 1
+	if state.showBars && len(metrics) > 0 {
+		rowMultiplier = 2
+	}
+	totalRows := len(data)
+	state.rowCount = totalRows
+	state.moveSelection(0) // keep the selection on a row that still exists
+	first, end := state.scrollWindow(totalRows, (tableHeight-footerHeight-3)/rowMultiplier)
+	state.windowEnd, state.rowLines = end, rowMultiplier
+	data = data[first:end]
+	if len(metrics) > 0 {
+		metrics = metrics[first:end]
+	}
+
 	// Add progress bars to data if enabled
 	if state.showBars && len(metrics) > 0 {
 		// Calculate base column count (number of non-resource columns to skip)
@@ -609,33 +893,26 @@ func updateDisplay(k8sClient *kubernetes.Clientset, gc *GlanceConfig, state *Liv
 				baseColCount++
 			}
 		}
+		if state.mode == ViewPodDetail {
+			// CONTAINER, IMAGE
+			baseColCount = 2
+		}
 		// GPU column comes after resource columns but before cloud, so we
 		// don't add it to baseColCount (it IS a resource column).
 		data = addProgressBars(data, metrics, state.showPercentages, baseColCount, state.mode)
 	}
 
-	// Calculate summary stats
-	summaryStats := calculateSummaryStats(metrics)
-
-	// Calculate table height based on compact mode (leave room for summary and shortcuts)
-	summaryHeight := 3
-	shortcutsHeight := 2                                            // Changed from 1 to 2 for two-line menu
-	tableHeight := termHeight - 4 - summaryHeight - shortcutsHeight // 4 = status bar (1) + borders (3)
-	if state.compactMode {
-		tableHeight = termHeight - 4 - shortcutsHeight
-		summaryHeight = 0
-	}
-
 	// Update table
 	state.table.Rows = append([][]string{header}, data...)
 	state.table.TextStyle = ui.NewStyle(ui.ColorWhite)
 	state.table.RowSeparator = false
 	state.table.BorderStyle = ui.NewStyle(ui.ColorCyan)
-	state.table.SetRect(0, summaryHeight, termWidth, tableHeight+summaryHeight)
+	state.table.SetRect(0, summaryHeight, termWidth, tableHeight+summaryHeight-footerHeight)
 	state.table.RowStyles[0] = ui.NewStyle(ui.ColorWhite, ui.ColorBlack, ui.ModifierBold)
 
-	// Apply row coloring based on utilization (skip for deployments - they don't have usage metrics)
-	if state.mode != ViewDeployments {
+	// Apply row coloring based on utilization (skip for deployments and
+	// pending pods - they don't have usage metrics)
+	if state.mode != ViewDeployments && state.mode != ViewPending {
 		applyRowColors(state.table, metrics, state.showBars)
 	} else {
 		// Clear any previous row styles for deployments
@@ -644,32 +921,50 @@ func updateDisplay(k8sClient *kubernetes.Clientset, gc *GlanceConfig, state *Liv
 		}
 	}
 
-	// Highlight selected namespace row if in namespace view
-	if state.mode == ViewNamespaces && len(state.namespaceList) > 0 {
-		rowMultiplier := getRowMultiplier(state.showBars)
-		selectedRow := (state.selectedNamespaceIndex * rowMultiplier) + 1 // +1 for header
+	// Highlight the selected row if it is in the visible window
+	if sel := state.selectedDataRow(); sel >= first && sel < end {
+		selectedRow := ((sel - first) * rowMultiplier) + 1 // +1 for header
 		if selectedRow < len(state.table.Rows) {
 			state.table.RowStyles[selectedRow] = ui.NewStyle(ui.ColorBlack, ui.ColorCyan, ui.ModifierBold)
 		}
 	}
 
-	// Render summary bar at the top (if not compact)
-	if !state.compactMode {
+	// Render the panels of the detail screens, otherwise the summary bar
+	// at the top (if not compact)
+	if isDetailMode(state.mode) {
+		title := " Node: " + state.detailNode + " "
+		if state.mode == ViewPodDetail {
+			title = " Pod: " + state.detailPod + " "
+		}
+		renderDetailPanel(title, state.detailHeader, 0, termWidth)
+		if footerHeight > 0 {
+			renderDetailPanel(" Recent Events ", state.detailFooter, tableHeight+summaryHeight-footerHeight, termWidth)
+		}
+	} else if !state.compactMode {
 		renderSummaryBar(
 			summaryStats, termWidth, state.mode, state.selectedNamespace,
 			state.nodeLimit, state.podLimit, state.totalNodes, state.totalPods,
 			state.contextName, state.cloudProvider, state.cloudCluster,
+			state.metricsAvailable,
 		)
 	}
 
-	// Update status bar with limit information
+	// Update status bar with the visible rows and limit information
 	modeStr := getModeString(state.mode)
-	viewingInfo := ""
+	keys := state.keyMap()
+	viewingInfo := " | " + rowsIndicator(first, end, totalRows)
 	switch state.mode {
 	case ViewNodes:
-		viewingInfo = fmt.Sprintf(" | Viewing Nodes: %d/%d", min(state.nodeLimit, state.totalNodes), state.totalNodes)
+		viewingInfo += fmt.Sprintf(" | Nodes: %d/%d", shownCount(state.nodeLimit, state.totalNodes), state.totalNodes)
 	case ViewPods:
-		viewingInfo = fmt.Sprintf(" | Viewing Pods: %d/%d", min(state.podLimit, state.totalPods), state.totalPods)
+		viewingInfo += fmt.Sprintf(" | Pods: %d/%d", shownCount(state.podLimit, state.totalPods), state.totalPods)
+	case ViewNodeDetail:
+		viewingInfo += fmt.Sprintf(" | Node: %s | Pods: %d", state.detailNode, totalRows)
+	case ViewPodDetail:
+		viewingInfo += fmt.Sprintf(" | Pod: %s | Containers: %d", state.detailPod, totalRows)
+	}
+	if back := keys.hints(actionBack, "Back"); isDetailMode(state.mode) && back != "" {
+		viewingInfo += " | " + back
 	}
 
 	// Add filter info if active
@@ -685,6 +980,13 @@ func updateDisplay(k8sClient *kubernetes.Clientset, gc *GlanceConfig, state *Liv
 		}
 		filterInfo += fmt.Sprintf("Capacity=%s", state.filterCapacityType)
 	}
+	filterInfo += filterStatusText(state)
+
+	// Flag allocation-only data so n/a usage columns are not mistaken for idle.
+	metricsInfo := ""
+	if !state.metricsAvailable && modeHasUsage(state.mode) {
+		metricsInfo = " | [⚠ Metrics unavailable: allocation only](fg:yellow)"
+	}
 
 	// Add dirty indicator if modal is open
 	dirtyIndicator := ""
@@ -692,20 +994,29 @@ func updateDisplay(k8sClient *kubernetes.Clientset, gc *GlanceConfig, state *Liv
 		dirtyIndicator = " | [⚠ Unsaved Changes](fg:yellow)"
 	}
 
-	sortInfo := fmt.Sprintf(" | Sort: %s ([1]status [2]name [3]cpu [4]memory)", getSortModeString(state.sortMode))
+	sortInfo := " | Sort: " + getSortModeString(state.sortMode)
+	if sortKeys := keys.hints(actionSortStatus, "status", actionSortName, "name",
+		actionSortCPU, "cpu", actionSortMemory, "memory"); sortKeys != "" {
+		sortInfo += " (" + sortKeys + ")"
+	}
 
-	state.statusBar.Text = fmt.Sprintf(" %s | Updated: %s%s%s%s%s | [?]Settings [q]Quit",
+	state.statusBar.Text = fmt.Sprintf(" %s | Updated: %s%s%s%s%s%s | %s",
 		modeStr,
 		state.lastUpdate.Format("15:04:05"),
 		viewingInfo,
 		filterInfo,
 		sortInfo,
-		dirtyIndicator)
+		metricsInfo,
+		dirtyIndicator,
+		keys.hints(actionSettings, "Settings", actionQuit, "Quit"))
+	if state.filterEditing {
+		state.statusBar.Text = filterInputText(state)
+	}
 	state.statusBar.Border = false
 	state.statusBar.SetRect(0, tableHeight+summaryHeight+2, termWidth, tableHeight+summaryHeight+3)
 
 	// Position and render shortcuts bar
-	state.menuBar.SetRect(0, tableHeight+summaryHeight, termWidth, tableHeight+summaryHeight+1)
+	state.menuBar.SetRect(0, tableHeight+summaryHeight, termWidth, tableHeight+summaryHeight+shortcutsHeight)
 
 	// Render base UI
 	ui.Render(state.table, state.menuBar, state.statusBar)
@@ -802,11 +1113,35 @@ func calculateSummaryStats(metrics []ResourceMetrics) SummaryStats {
 	return stats
 }
 
+// modeHasUsage reports whether a view shows usage metrics.
+func modeHasUsage(mode ViewMode) bool {
+	return mode == ViewNodes || mode == ViewNamespaces || mode == ViewPods || isDetailMode(mode)
+}
+
+// isDetailMode reports whether a view is a node or pod detail screen.
+func isDetailMode(mode ViewMode) bool {
+	return mode == ViewNodeDetail || mode == ViewPodDetail
+}
+
+// renderDetailPanel renders a bordered panel of lines at row y, used for the
+// header and footer of the detail screens.
+func renderDetailPanel(title string, lines []string, y, width int) {
+	p := widgets.NewParagraph()
+	p.Border = true
+	p.BorderStyle = ui.NewStyle(ui.ColorCyan)
+	p.Title = title
+	p.TitleStyle = ui.NewStyle(ui.ColorCyan, ui.ColorBlack, ui.ModifierBold)
+	p.Text = " " + strings.Join(lines, "\n ")
+	p.SetRect(0, y, width, y+len(lines)+2)
+	ui.Render(p)
+}
+
 // renderSummaryBar renders a summary bar at the top of the screen
 func renderSummaryBar(
 	stats SummaryStats, width int, mode ViewMode, selectedNamespace string,
 	nodeLimit, podLimit, totalNodes, totalPods int,
 	contextName, cloudProvider, cloudCluster string,
+	metricsAvailable bool,
 ) {
 	summary := widgets.NewParagraph()
 	summary.Border = true
@@ -824,7 +1159,7 @@ func renderSummaryBar(
 
 	// Add namespace display for scoped views
 	namespaceInfo := ""
-	if mode == ViewPods || mode == ViewDeployments {
+	if mode == ViewPods || mode == ViewDeployments || mode == ViewPending {
 		nsDisplay := "All Namespaces"
 		if selectedNamespace != "" {
 			nsDisplay = selectedNamespace
@@ -837,11 +1172,11 @@ func renderSummaryBar(
 	if mode == ViewNodes && totalNodes > 0 {
 		viewingInfo = fmt.Sprintf(
 			" │ [Viewing Nodes:](fg:cyan,mod:bold) [%d/%d](fg:white)",
-			min(nodeLimit, totalNodes), totalNodes)
+			shownCount(nodeLimit, totalNodes), totalNodes)
 	} else if mode == ViewPods && totalPods > 0 {
 		viewingInfo = fmt.Sprintf(
 			" │ [Viewing Pods:](fg:cyan,mod:bold) [%d/%d](fg:white)",
-			min(podLimit, totalPods), totalPods)
+			shownCount(podLimit, totalPods), totalPods)
 	}
 
 	// Add large-cluster hint when we are only showing a subset of nodes.
@@ -866,11 +1201,16 @@ func renderSummaryBar(
 		}
 	}
 
+	usageInfo := fmt.Sprintf("CPU: %s %.0f%%%% │ Mem: %s %.0f%%",
+		cpuBar, stats.AvgCPUUsage, memBar, stats.AvgMemUsage)
+	if !metricsAvailable && modeHasUsage(mode) {
+		usageInfo = "[⚠ Usage n/a: allocation only](fg:yellow,mod:bold)"
+	}
+
 	summary.Text = fmt.Sprintf(
-		" Status: %s %s %s │ CPU: %s %.0f%%%% │ Mem: %s %.0f%%%s%s%s%s%s",
+		" Status: %s %s %s │ %s%s%s%s%s%s",
 		healthIcon, warnIcon, critIcon,
-		cpuBar, stats.AvgCPUUsage,
-		memBar, stats.AvgMemUsage,
+		usageInfo,
 		namespaceInfo,
 		viewingInfo,
 		largeClusterHint,
@@ -967,6 +1307,7 @@ type nsRowData struct {
 	row      []string
 	metrics  ResourceMetrics
 	cpuUsage float64
+	labels   map[string]string
 }
 
 func fetchNamespaceData(
@@ -986,73 +1327,52 @@ func fetchNamespaceData(
 	if state.showGPU {
 		header = append(header, "GPU REQ/LIMIT")
 	}
+	header = append(header, "OOM KILLED")
 
-	// Use watch cache for faster response
-	namespaces, err := k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
-		ResourceVersion: "0",
-	})
+	namespaces, err := state.listNamespaces(ctx, k8sClient)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to list namespaces: %w", err)
 	}
 
-	metricsClient, err := metricsclientset.NewForConfig(gc.restConfig)
-	if err != nil {
-		return header, [][]string{}, []ResourceMetrics{}, nil
-	}
-
 	// Fetch ALL pods and metrics in parallel (instead of per-namespace queries)
 	g, gCtx := errgroup.WithContext(ctx)
 
-	var allPods *v1.PodList
-	var allPodMetrics *metricsV1beta1api.PodMetricsList
+	var allPods []v1.Pod
+	var metricsByPod map[string]*metricsV1beta1api.PodMetrics
 
 	g.Go(func() error {
 		var err error
-		allPods, err = k8sClient.CoreV1().Pods("").List(gCtx, metav1.ListOptions{
-			ResourceVersion: "0",
-		})
+		allPods, err = state.listPods(gCtx, k8sClient, "", "")
 		return err
 	})
 
-	g.Go(func() error {
-		var err error
-		allPodMetrics, err = metricsClient.MetricsV1beta1().PodMetricses("").List(gCtx, metav1.ListOptions{
-			ResourceVersion: "0",
+	var metricsErr error
+	if state.metricsSource != nil {
+		g.Go(func() error {
+			metricsByPod, metricsErr = state.metricsSource.PodMetrics(gCtx, "")
+			return nil // Don't fail on metrics error
 		})
-		if err != nil {
-			log.Debugf("Failed to fetch pod metrics: %v", err)
-		}
-		return nil // Don't fail on metrics error
-	})
+	}
 
 	if err := g.Wait(); err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to fetch pods: %w", err)
 	}
+	if err := state.recordMetricsResult(metricsErr); err != nil {
+		return nil, nil, nil, err
+	}
 
 	// Group pods by namespace
 	podsByNS := make(map[string][]v1.Pod)
-	if allPods != nil {
-		for _, pod := range allPods.Items {
-			podsByNS[pod.Namespace] = append(podsByNS[pod.Namespace], pod)
-		}
-	}
-
-	// Group metrics by namespace+pod
-	metricsByPod := make(map[string]*metricsV1beta1api.PodMetrics)
-	if allPodMetrics != nil {
-		for i := range allPodMetrics.Items {
-			pm := &allPodMetrics.Items[i]
-			key := pm.Namespace + "/" + pm.Name
-			metricsByPod[key] = pm
-		}
+	for _, pod := range allPods {
+		podsByNS[pod.Namespace] = append(podsByNS[pod.Namespace], pod)
 	}
 
 	// Process namespaces in parallel
-	nsData := make([]nsRowData, len(namespaces.Items))
+	nsData := make([]nsRowData, len(namespaces))
 	var wg sync.WaitGroup
 	sem := make(chan struct{}, state.maxConcurrent)
 
-	for i, ns := range namespaces.Items {
+	for i, ns := range namespaces {
 		wg.Add(1)
 		go func(idx int, ns v1.Namespace) {
 			defer wg.Done()
@@ -1062,10 +1382,24 @@ func fetchNamespaceData(
 			nsData[idx] = processNamespacePods(
 				ns.Name, podsByNS[ns.Name], metricsByPod, state,
 			)
+			nsData[idx].labels = ns.Labels
 		}(i, ns)
 	}
 	wg.Wait()
 
+	// Apply the filter expression
+	if state.filter != nil {
+		filtered := nsData[:0]
+		for _, nd := range nsData {
+			if state.filter.match(liveFilterRow{
+				name: nd.row[0], namespace: nd.row[0], labels: nd.labels, metrics: &nd.metrics,
+			}) {
+				filtered = append(filtered, nd)
+			}
+		}
+		nsData = filtered
+	}
+
 	// Sort based on sort mode
 	switch state.sortMode {
 	case SortByName:
@@ -1119,8 +1453,10 @@ func processNamespacePods(
 	memUsage := resource.NewQuantity(0, resource.BinarySI)
 	gpuReq := resource.NewQuantity(0, resource.DecimalSI)
 	gpuLimit := resource.NewQuantity(0, resource.DecimalSI)
+	oomKills := 0
 
-	for _, pod := range pods {
+	for i, pod := range pods {
+		oomKills += core.CountOOMKills(&pods[i])
 		for _, container := range pod.Spec.Containers {
 			if req := container.Resources.Requests.Cpu(); req != nil {
 				cpuReq.Add(*req)
@@ -1146,8 +1482,7 @@ func processNamespacePods(
 			}
 		}
 
-		key := pod.Namespace + "/" + pod.Name
-		if pm, ok := metricsByPod[key]; ok {
+		if pm, ok := metricsByPod[metricsource.PodKey(pod.Namespace, pod.Name)]; ok {
 			for _, container := range pm.Containers {
 				cpuUsage.Add(container.Usage[v1.ResourceCPU])
 				memUsage.Add(container.Usage[v1.ResourceMemory])
@@ -1163,9 +1498,9 @@ func processNamespacePods(
 	row := []string{
 		nsName,
 		formatResourceRatio(cpuReq, cpuLimit, false, state.showRawResources),
-		formatResourceRatio(cpuUsage, cpuLimit, false, state.showRawResources),
+		formatUsageRatio(cpuUsage, cpuLimit, false, state.showRawResources, state.metricsAvailable),
 		formatResourceRatio(memReq, memLimit, true, state.showRawResources),
-		formatResourceRatio(memUsage, memLimit, true, state.showRawResources),
+		formatUsageRatio(memUsage, memLimit, true, state.showRawResources, state.metricsAvailable),
 		fmt.Sprintf("%d", len(pods)),
 	}
 	if state.showGPU {
@@ -1175,6 +1510,7 @@ func processNamespacePods(
 			row = append(row, "—")
 		}
 	}
+	row = append(row, fmt.Sprintf("%d", oomKills))
 
 	metrics := ResourceMetrics{
 		CPURequest:  float64(cpuReq.MilliValue()) / 1000.0,
@@ -1185,6 +1521,8 @@ func processNamespacePods(
 		MemLimit:    float64(memLimit.Value()),
 		MemUsage:    float64(memUsage.Value()),
 		MemCapacity: float64(memLimit.Value()),
+
+		UsageUnknown: !state.metricsAvailable,
 	}
 
 	return nsRowData{
@@ -1196,11 +1534,13 @@ func processNamespacePods(
 
 // podRowData holds data for a single pod row for sorting and limiting.
 type podRowData struct {
-	row       []string
-	metrics   ResourceMetrics
-	isRunning bool
-	cpuUsage  float64
-	memUsage  float64
+	row        []string
+	metrics    ResourceMetrics
+	isRunning  bool
+	cpuUsage   float64
+	memUsage   float64
+	key        string
+	containers []ContainerSummaryRow
 }
 
 func fetchPodData(
@@ -1216,27 +1556,28 @@ func fetchPodData(
 		"CPU USAGE/LIMITS",
 		"MEMORY REQUESTS/LIMITS",
 		"MEMORY USAGE/LIMITS",
-		"STATUS",
 	}
 	if state.showGPU {
-		// Insert GPU column before STATUS
-		header = append(header[:len(header)-1], "GPU REQ/LIMIT", header[len(header)-1])
-	}
-
-	metricsClient, err := metricsclientset.NewForConfig(gc.restConfig)
-	if err != nil {
-		// Metrics are best-effort; log and proceed without usage data.
-		log.Debugf("Failed to create metrics client for pods: %v", err)
-		metricsClient = nil
+		header = append(header, "GPU REQ/LIMIT")
 	}
+	header = append(header, "STATUS", "RESTARTS", "LAST TERMINATION")
 
-	// Use shared aggregation helper so that static and live views stay in sync.
-	podSummaries, err := CollectPodStats(ctx, k8sClient, metricsClient, namespace, nil)
+	pods, err := state.listPods(ctx, k8sClient, namespace, "")
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to list pods: %w", err)
 	}
+	// Use shared aggregation helper so that static and live views stay in sync.
+	podSummaries := summarizePods(ctx, pods, state.metricsSource, namespace)
 
-	state.totalPods = len(podSummaries)
+	if len(podSummaries) > 0 {
+		var metricsErr error
+		if !podSummaries[0].MetricsAvailable {
+			metricsErr = fmt.Errorf("pod metrics unavailable")
+		}
+		if err := state.recordMetricsResult(metricsErr); err != nil {
+			return nil, nil, nil, err
+		}
+	}
 
 	podData := make([]podRowData, 0, len(podSummaries))
 	for _, ps := range podSummaries {
@@ -1264,9 +1605,9 @@ func fetchPodData(
 		row := []string{
 			ps.Name,
 			formatResourceRatio(cpuReq, cpuLimit, false, state.showRawResources),
-			formatResourceRatio(cpuUsage, cpuLimit, false, state.showRawResources),
+			formatUsageRatio(cpuUsage, cpuLimit, false, state.showRawResources, ps.MetricsAvailable),
 			formatResourceRatio(memReq, memLimit, true, state.showRawResources),
-			formatResourceRatio(memUsage, memLimit, true, state.showRawResources),
+			formatUsageRatio(memUsage, memLimit, true, state.showRawResources, ps.MetricsAvailable),
 		}
 		if state.showGPU {
 			if ps.GPUReq != nil && ps.GPUReq.Value() > 0 {
@@ -1279,7 +1620,11 @@ func fetchPodData(
 				row = append(row, "—")
 			}
 		}
-		row = append(row, statusIcon+podStatus)
+		row = append(row,
+			statusIcon+podStatus,
+			fmt.Sprintf("%d", ps.Restarts),
+			formatTermination(ps.LastTerminationReason, ps.LastTerminationTime),
+		)
 
 		metrics := ResourceMetrics{
 			CPURequest:  float64(cpuReq.MilliValue()) / 1000.0,
@@ -1290,19 +1635,72 @@ func fetchPodData(
 			MemLimit:    float64(memLimit.Value()),
 			MemUsage:    float64(memUsage.Value()),
 			MemCapacity: float64(memLimit.Value()),
+
+			UsageUnknown: !ps.MetricsAvailable,
+		}
+		if !state.filter.match(liveFilterRow{
+			name: ps.Name, namespace: ps.Namespace, status: ps.Status, node: ps.NodeName,
+			labels: ps.Labels, metrics: &metrics,
+		}) {
+			continue
 		}
 
 		podData = append(podData, podRowData{
-			row:       row,
-			metrics:   metrics,
-			isRunning: isRunning,
-			cpuUsage:  cpuUsagePct,
-			memUsage:  memUsagePct,
+			row:        row,
+			metrics:    metrics,
+			isRunning:  isRunning,
+			cpuUsage:   cpuUsagePct,
+			memUsage:   memUsagePct,
+			key:        ps.Namespace + "/" + ps.Name,
+			containers: ps.Containers,
 		})
 	}
 
+	// Update total after filtering
+	state.totalPods = len(podData)
+
 	// Sort based on sort mode
-	switch state.sortMode {
+	sortPodData(podData, state.sortMode)
+
+	// Apply pod limit
+	limit := len(podData)
+	if state.podLimit > 0 && state.podLimit < limit {
+		limit = state.podLimit
+	}
+
+	// Build final rows and metrics, interleaving container rows beneath
+	// any pods the user has expanded.
+	rows := make([][]string, 0, limit)
+	metrics := make([]ResourceMetrics, 0, limit)
+	state.podKeys = make([]string, 0, limit)
+	state.podRowIndex = make([]int, 0, limit)
+	for i := 0; i < limit; i++ {
+		state.podKeys = append(state.podKeys, podData[i].key)
+		state.podRowIndex = append(state.podRowIndex, len(rows))
+		rows = append(rows, podData[i].row)
+		metrics = append(metrics, podData[i].metrics)
+
+		if !state.expandedPods[podData[i].key] {
+			continue
+		}
+		for _, c := range podData[i].containers {
+			cRow, cMetrics := buildContainerLiveRow(c, state)
+			rows = append(rows, cRow)
+			metrics = append(metrics, cMetrics)
+		}
+	}
+
+	// Keep the selection within bounds as pods come and go.
+	if state.selectedPodIndex >= len(state.podKeys) {
+		state.selectedPodIndex = 0
+	}
+
+	return header, rows, metrics, nil
+}
+
+// sortPodData sorts pod rows based on sort mode.
+func sortPodData(podData []podRowData, mode SortMode) {
+	switch mode {
 	case SortByStatus:
 		// Non-running pods first (Pending, Failed, etc.)
 		sort.Slice(podData, func(i, j int) bool {
@@ -1324,22 +1722,43 @@ func fetchPodData(
 			return podData[i].memUsage > podData[j].memUsage
 		})
 	}
+}
 
-	// Apply pod limit
-	limit := len(podData)
-	if state.podLimit > 0 && state.podLimit < limit {
-		limit = state.podLimit
+// buildContainerLiveRow builds an indented container row for an expanded pod
+// in the live Pods view. The column layout matches the pod rows so progress
+// bars and row colors line up.
+func buildContainerLiveRow(c ContainerSummaryRow, state *LiveState) ([]string, ResourceMetrics) {
+	row := []string{
+		"  └ " + c.Name,
+		formatResourceRatio(c.CPUReq, c.CPULimit, false, state.showRawResources),
+		formatUsageRatio(c.CPUUsage, c.CPULimit, false, state.showRawResources, state.metricsAvailable),
+		formatResourceRatio(c.MemReq, c.MemLimit, true, state.showRawResources),
+		formatUsageRatio(c.MemUsage, c.MemLimit, true, state.showRawResources, state.metricsAvailable),
+	}
+	if state.showGPU {
+		row = append(row, "")
 	}
 
-	// Build final rows and metrics
-	rows := make([][]string, 0, limit)
-	metrics := make([]ResourceMetrics, 0, limit)
-	for i := 0; i < limit; i++ {
-		rows = append(rows, podData[i].row)
-		metrics = append(metrics, podData[i].metrics)
+	row = append(row,
+		"",
+		fmt.Sprintf("%d", c.RestartCount),
+		formatTermination(c.LastTerminationReason, c.LastTerminationTime),
+	)
+
+	metrics := ResourceMetrics{
+		CPURequest:  float64(c.CPUReq.MilliValue()) / 1000.0,
+		CPULimit:    float64(c.CPULimit.MilliValue()) / 1000.0,
+		CPUUsage:    float64(c.CPUUsage.MilliValue()) / 1000.0,
+		CPUCapacity: float64(c.CPULimit.MilliValue()) / 1000.0,
+		MemRequest:  float64(c.MemReq.Value()),
+		MemLimit:    float64(c.MemLimit.Value()),
+		MemUsage:    float64(c.MemUsage.Value()),
+		MemCapacity: float64(c.MemLimit.Value()),
+
+		UsageUnknown: !state.metricsAvailable,
 	}
 
-	return header, rows, metrics, nil
+	return row, metrics
 }
 
 // nodeRowData holds data for a single node row for parallel processing.
@@ -1357,6 +1776,7 @@ type nodeRowData struct {
 	nodeGroup      string
 	fargateProfile string
 	capacityType   string
+	labels         map[string]string
 }
 
 // buildNodeHeader constructs the table header based on toggle states.
@@ -1379,11 +1799,15 @@ func buildNodeHeader(state *LiveState) []string {
 		"MEMORY ALLOCATED/CAPACITY",
 		"MEMORY USAGE/CAPACITY",
 		"PODS",
+		"OOM KILLED",
 	)
 
 	if state.showGPU {
 		header = append(header, "GPU REQ/ALLOC")
 	}
+	if state.showStorage {
+		header = append(header, "EPHEMERAL", "FS USED/CAP", "NET RX/TX")
+	}
 
 	if state.showCloudInfo {
 		header = append(header, "PROVIDER", "REGION", "INSTANCE TYPE", "CAPACITY")
@@ -1392,41 +1816,65 @@ func buildNodeHeader(state *LiveState) []string {
 	return header
 }
 
-// fetchNodeMetricsAndPods fetches node metrics and pods in parallel.
+// recordMetricsResult updates metricsAvailable after a usage fetch. Under
+// --metrics=required a failure is returned; otherwise the view falls back
+// to allocation-only rows.
+func (s *LiveState) recordMetricsResult(metricsErr error) error {
+	if s.metricsSource == nil {
+		s.metricsAvailable = false
+		return nil
+	}
+	if metricsErr != nil {
+		if s.metricsMode == metricsModeRequired {
+			return metricsRequiredError(s.metricsSource, metricsErr)
+		}
+		log.Debugf("Usage metrics unavailable from %s: %v", s.metricsSource.Name(), metricsErr)
+		s.metricsAvailable = false
+		return nil
+	}
+	s.metricsAvailable = true
+	return nil
+}
+
+// nodeFetchResult holds the node metrics and pods fetched for the Nodes
+// view. metricsErr records why usage metrics could not be fetched; it is
+// not a failure of the fetch, as the view falls back to allocation-only data.
+type nodeFetchResult struct {
+	nodeMetrics map[string]*metricsV1beta1api.NodeMetrics
+	pods        []v1.Pod
+	metricsErr  error
+}
+
+// fetchNodeMetricsAndPods fetches node metrics and non-terminated pods in
+// parallel. Only a failure to list pods is returned as an error.
 func fetchNodeMetricsAndPods(
 	ctx context.Context,
 	k8sClient *kubernetes.Clientset,
-	metricsClient *metricsclientset.Clientset,
-) (*metricsV1beta1api.NodeMetricsList, *v1.PodList, error) {
+	state *LiveState,
+) (nodeFetchResult, error) {
 	g, gCtx := errgroup.WithContext(ctx)
 
-	var nodeMetrics *metricsV1beta1api.NodeMetricsList
-	var allPods *v1.PodList
+	metricsSource := state.metricsSource
+	var result nodeFetchResult
 
-	// Fetch node metrics in parallel
-	g.Go(func() error {
-		var err error
-		nodeMetrics, err = metricsClient.MetricsV1beta1().NodeMetricses().List(gCtx, metav1.ListOptions{
-			ResourceVersion: "0",
+	// Fetch node metrics in parallel. A metrics failure is recorded in the
+	// result so callers can fall back to allocation-only data.
+	if metricsSource != nil {
+		g.Go(func() error {
+			result.nodeMetrics, result.metricsErr = metricsSource.NodeMetrics(gCtx)
+			return nil
 		})
-		return err
-	})
+	}
 
-	// Fetch ALL pods once (instead of per-node queries) using watch cache
+	// Fetch ALL pods once (instead of per-node queries)
 	g.Go(func() error {
 		var err error
-		allPods, err = k8sClient.CoreV1().Pods("").List(gCtx, metav1.ListOptions{
-			ResourceVersion: "0",
-			FieldSelector:   "status.phase!=Succeeded,status.phase!=Failed",
-		})
+		result.pods, err = state.listPods(gCtx, k8sClient, "", activePodFieldSelector)
 		return err
 	})
 
-	if err := g.Wait(); err != nil {
-		return nodeMetrics, allPods, err
-	}
-
-	return nodeMetrics, allPods, nil
+	err := g.Wait()
+	return result, err
 }
 
 // processNodeRow builds a single node row with metrics using aggregated
@@ -1505,10 +1953,11 @@ func processNodeRow(
 	// Add resource columns
 	row = append(row,
 		formatResourceRatio(&cpuAlloc, cpuCap, false, state.showRawResources),
-		formatResourceRatio(&cpuUsage, cpuCap, false, state.showRawResources),
+		formatUsageRatio(&cpuUsage, cpuCap, false, state.showRawResources, state.metricsAvailable),
 		formatResourceRatio(&memAlloc, memCap, true, state.showRawResources),
-		formatResourceRatio(&memUsage, memCap, true, state.showRawResources),
+		formatUsageRatio(&memUsage, memCap, true, state.showRawResources, state.metricsAvailable),
 		fmt.Sprintf("%d", podCount),
+		fmt.Sprintf("%d", stats.OOMKills),
 	)
 
 	// GPU columns (only when toggled on)
@@ -1525,6 +1974,14 @@ func processNodeRow(
 		}
 	}
 
+	if state.showStorage {
+		row = append(row,
+			formatByteQuantity(stats.UsageEphemeralStorage),
+			formatBytePair(stats.FSUsed, stats.FSCapacity),
+			formatBytePair(stats.NetworkRxBytes, stats.NetworkTxBytes),
+		)
+	}
+
 	metrics := ResourceMetrics{
 		CPURequest:  float64(cpuAlloc.MilliValue()) / 1000.0,
 		CPULimit:    float64(cpuCap.MilliValue()) / 1000.0,
@@ -1534,6 +1991,8 @@ func processNodeRow(
 		MemLimit:    float64(memCap.Value()),
 		MemUsage:    float64(memUsage.Value()),
 		MemCapacity: float64(memCap.Value()),
+
+		UsageUnknown: !state.metricsAvailable,
 	}
 
 	rowData := nodeRowData{
@@ -1546,6 +2005,7 @@ func processNodeRow(
 		nodeVersion:  node.Status.NodeInfo.KubeletVersion,
 		providerID:   node.Spec.ProviderID,
 		nodeGroup:    nodeGroup,
+		labels:       node.Labels,
 	}
 
 	// Get region from labels
@@ -1723,68 +2183,49 @@ func fetchNodeData(
 	gc *GlanceConfig,
 	state *LiveState,
 ) ([]string, [][]string, []ResourceMetrics, error) {
-	header := buildNodeHeader(state)
-
-	// Use watch cache for faster response (resourceVersion="0")
-	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{
-		ResourceVersion: "0",
-	})
+	nodes, err := state.listNodes(ctx, k8sClient)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to list nodes: %w", err)
 	}
 
-	state.totalNodes = len(nodes.Items)
-
-	metricsClient, err := metricsclientset.NewForConfig(gc.restConfig)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("unable to create metrics client: %w", err)
-	}
+	state.totalNodes = len(nodes)
 
 	// Fetch all data in parallel
-	nodeMetrics, allPods, err := fetchNodeMetricsAndPods(ctx, k8sClient, metricsClient)
+	fetched, err := fetchNodeMetricsAndPods(ctx, k8sClient, state)
 	if err != nil {
-		if isMetricsServerNotAvailable(err) {
-			msg := "metrics-server (metrics.k8s.io) is required for glance live to operate. " +
-				"Install the Kubernetes metrics-server add-on or your cloud provider's metrics extension."
-			log.Warnf("%s: %v", msg, err)
-			return nil, nil, nil, fmt.Errorf("%s", msg)
-		}
-		return nil, nil, nil, fmt.Errorf("failed to fetch metrics or pods: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to fetch pods: %w", err)
 	}
-
-	// Build metrics map
-	metricsMap := make(map[string]*metricsV1beta1api.NodeMetrics)
-	if nodeMetrics != nil {
-		for i := range nodeMetrics.Items {
-			metricsMap[nodeMetrics.Items[i].Name] = &nodeMetrics.Items[i]
-		}
+	if err := state.recordMetricsResult(fetched.metricsErr); err != nil {
+		return nil, nil, nil, err
 	}
 
 	// Group pods by node name (O(n) instead of O(n*m) API calls)
 	podsByNode := make(map[string][]v1.Pod)
-	if allPods != nil {
-		for _, pod := range allPods.Items {
-			nodeName := pod.Spec.NodeName
-			if nodeName != "" {
-				podsByNode[nodeName] = append(podsByNode[nodeName], pod)
-			}
+	for _, pod := range fetched.pods {
+		nodeName := pod.Spec.NodeName
+		if nodeName != "" {
+			podsByNode[nodeName] = append(podsByNode[nodeName], pod)
 		}
 	}
 
 	// Use shared core aggregation to compute NodeStats first.
 	snapshotOpts := core.NodeSnapshotOptions{RequireMetrics: false}
-	nm, _, err := core.ComputeNodeSnapshot(nodes.Items, podsByNode, metricsMap, snapshotOpts)
+	nm, _, err := core.ComputeNodeSnapshot(nodes, podsByNode, fetched.nodeMetrics, snapshotOpts)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to compute node snapshot: %w", err)
 	}
+	if applyExtendedNodeUsage(ctx, state.metricsSource, nm) {
+		state.showStorage = true
+	}
+	header := buildNodeHeader(state)
 
 	// Process nodes in parallel with semaphore for concurrency limit
-	nodeData := make([]nodeRowData, len(nodes.Items))
+	nodeData := make([]nodeRowData, len(nodes))
 	var mu sync.Mutex
 	sem := make(chan struct{}, state.maxConcurrent)
 
 	var wg sync.WaitGroup
-	for i, node := range nodes.Items {
+	for i, node := range nodes {
 		wg.Add(1)
 		go func(idx int, node v1.Node) {
 			defer wg.Done()
@@ -1812,6 +2253,7 @@ func fetchNodeData(
 
 	// Update total after filtering
 	state.totalNodes = len(nodeData)
+	state.nodeSnapshot = newLiveNodeSnapshot(nodes, podsByNode, nodeData)
 
 	// Apply node limit
 	limit := len(nodeData)
@@ -1822,9 +2264,16 @@ func fetchNodeData(
 	// Build final rows and metrics
 	rows := make([][]string, 0, limit)
 	metrics := make([]ResourceMetrics, 0, limit)
+	state.nodeKeys = make([]string, 0, limit)
 	for i := 0; i < limit; i++ {
 		rows = append(rows, nodeData[i].row)
 		metrics = append(metrics, nodeData[i].metrics)
+		state.nodeKeys = append(state.nodeKeys, nodeData[i].row[0])
+	}
+
+	// Keep the selection within bounds as nodes come and go.
+	if state.selectedNodeIndex >= len(state.nodeKeys) {
+		state.selectedNodeIndex = 0
 	}
 
 	return header, rows, metrics, nil
@@ -1832,12 +2281,22 @@ func fetchNodeData(
 
 // filterNodeData filters node data based on state filters.
 func filterNodeData(data []nodeRowData, state *LiveState) []nodeRowData {
-	if state.filterNodeGroup == "" && state.filterCapacityType == "" {
+	if state.filterNodeGroup == "" && state.filterCapacityType == "" && state.filter == nil {
 		return data // No filtering needed
 	}
 
 	filtered := make([]nodeRowData, 0, len(data))
 	for _, row := range data {
+		status := nodeStatusNotReady
+		if row.isReady {
+			status = nodeStatusReady
+		}
+		if !state.filter.match(liveFilterRow{
+			name: row.row[0], status: status, node: row.row[0], labels: row.labels, metrics: &row.metrics,
+		}) {
+			continue
+		}
+
 		// Filter by node group/pool if specified
 		if state.filterNodeGroup != "" {
 			if row.nodeGroup == "" || !strings.Contains(strings.ToLower(row.nodeGroup), strings.ToLower(state.filterNodeGroup)) {
@@ -1891,17 +2350,19 @@ func fetchDeploymentData(
 	ctx context.Context,
 	k8sClient *kubernetes.Clientset,
 	namespace string,
+	state *LiveState,
 ) ([]string, [][]string, []ResourceMetrics, error) {
 	header := []string{
 		"DEPLOYMENT", "STATUS", "CPU REQUESTS/LIMITS", "MEMORY REQUESTS/LIMITS",
 		"REPLICAS", "READY", "AVAILABLE",
 	}
 
-	// Use shared aggregation helper so that static and live deployment views stay in sync.
-	deploySummaries, err := CollectDeploymentStats(ctx, k8sClient, namespace, nil)
+	deployments, err := state.listDeployments(ctx, k8sClient, namespace)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to list deployments: %w", err)
 	}
+	// Use shared aggregation helper so that static and live deployment views stay in sync.
+	deploySummaries := summarizeDeployments(deployments)
 
 	rows := make([][]string, 0, len(deploySummaries))
 	metrics := make([]ResourceMetrics, 0, len(deploySummaries))
@@ -1912,6 +2373,25 @@ func fetchDeploymentData(
 		memReq := ds.MemReq
 		memLimit := ds.MemLimit
 
+		m := ResourceMetrics{
+			CPURequest:  float64(cpuReq.MilliValue()) / 1000.0,
+			CPULimit:    float64(cpuLimit.MilliValue()) / 1000.0,
+			CPUUsage:    0, // Deployments don't have direct usage metrics
+			CPUCapacity: float64(cpuLimit.MilliValue()) / 1000.0,
+			MemRequest:  float64(memReq.Value()),
+			MemLimit:    float64(memLimit.Value()),
+			MemUsage:    0,
+			MemCapacity: float64(memLimit.Value()),
+		}
+		// Usage filters never match deployments.
+		filterMetrics := m
+		filterMetrics.UsageUnknown = true
+		if !state.filter.match(liveFilterRow{
+			name: ds.Name, namespace: ds.Namespace, status: ds.Status, labels: ds.Labels, metrics: &filterMetrics,
+		}) {
+			continue
+		}
+
 		rows = append(rows, []string{
 			ds.Name,
 			ds.Status,
@@ -1921,20 +2401,71 @@ func fetchDeploymentData(
 			fmt.Sprintf("%d", ds.Ready),
 			fmt.Sprintf("%d", ds.Available),
 		})
+		metrics = append(metrics, m)
+	}
 
-		metrics = append(metrics, ResourceMetrics{
-			CPURequest:  float64(cpuReq.MilliValue()) / 1000.0,
-			CPULimit:    float64(cpuLimit.MilliValue()) / 1000.0,
-			CPUUsage:    0, // Deployments don't have direct usage metrics
-			CPUCapacity: float64(cpuLimit.MilliValue()) / 1000.0,
-			MemRequest:  float64(memReq.Value()),
-			MemLimit:    float64(memLimit.Value()),
-			MemUsage:    0,
-			MemCapacity: float64(memLimit.Value()),
+	return header, rows, metrics, nil
+}
+
+// fetchPendingData lists unscheduled pods with their requests, age and the
+// most relevant scheduling failure message. Pending pods have no usage, so no
+// metrics are returned and progress bars are not drawn for this view.
+func fetchPendingData(
+	ctx context.Context,
+	k8sClient *kubernetes.Clientset,
+	namespace string,
+	state *LiveState,
+) ([]string, [][]string, []ResourceMetrics, error) {
+	header := []string{"POD", "CPU REQUESTS", "MEMORY REQUESTS", "AGE", "REASON"}
+	if namespace == "" {
+		header[0] = "NAMESPACE/POD"
+	}
+
+	pods, err := state.listPods(ctx, k8sClient, namespace, pendingPodFieldSelector)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list pending pods: %w", err)
+	}
+	pending := summarizePendingPods(ctx, k8sClient, pods, namespace)
+
+	// Oldest first: long-pending pods are the most interesting.
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].CreationTime.Before(pending[j].CreationTime)
+	})
+
+	rows := make([][]string, 0, len(pending))
+	for _, p := range pending {
+		if !state.filter.match(liveFilterRow{
+			name: p.Name, namespace: p.Namespace, status: string(v1.PodPending), labels: p.Labels,
+		}) {
+			continue
+		}
+		name := p.Name
+		if namespace == "" {
+			name = p.Namespace + "/" + p.Name
+		}
+
+		age := "—"
+		if !p.CreationTime.IsZero() {
+			age = glanceutil.FormatAge(p.CreationTime)
+		}
+
+		// Prefer the latest FailedScheduling event; fall back to the
+		// PodScheduled condition when no event has been recorded yet.
+		reason := p.LastEventMessage
+		if reason == "" {
+			reason = pendingConditionText(p)
+		}
+
+		rows = append(rows, []string{
+			name,
+			formatMilliCPU(p.CPUReq),
+			formatBytes(p.MemReq),
+			age,
+			reason,
 		})
 	}
 
-	return header, rows, metrics, nil
+	return header, rows, nil, nil
 }
 
 func getSortModeString(mode SortMode) string {
@@ -1962,20 +2493,26 @@ func getModeString(mode ViewMode) string {
 		return "NODES"
 	case ViewDeployments:
 		return "DEPLOYMENTS"
+	case ViewPending:
+		return "PENDING"
+	case ViewNodeDetail:
+		return "NODE DETAIL"
+	case ViewPodDetail:
+		return "POD DETAIL"
 	default:
 		return "UNKNOWN"
 	}
 }
 
-func getPreviousNamespace(k8sClient *kubernetes.Clientset, current string) string {
-	ctx := context.Background()
-	namespaces, err := k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
-	if err != nil || len(namespaces.Items) == 0 {
+func getPreviousNamespace(k8sClient *kubernetes.Clientset, state *LiveState) string {
+	current := state.selectedNamespace
+	namespaces, err := state.listNamespaces(context.Background(), k8sClient)
+	if err != nil || len(namespaces) == 0 {
 		return current
 	}
 
-	names := make([]string, 0, len(namespaces.Items))
-	for _, ns := range namespaces.Items {
+	names := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
 		names = append(names, ns.Name)
 	}
 	sort.Strings(names)
@@ -1992,15 +2529,15 @@ func getPreviousNamespace(k8sClient *kubernetes.Clientset, current string) strin
 	return names[0]
 }
 
-func getNextNamespace(k8sClient *kubernetes.Clientset, current string) string {
-	ctx := context.Background()
-	namespaces, err := k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
-	if err != nil || len(namespaces.Items) == 0 {
+func getNextNamespace(k8sClient *kubernetes.Clientset, state *LiveState) string {
+	current := state.selectedNamespace
+	namespaces, err := state.listNamespaces(context.Background(), k8sClient)
+	if err != nil || len(namespaces) == 0 {
 		return current
 	}
 
-	names := make([]string, 0, len(namespaces.Items))
-	for _, ns := range namespaces.Items {
+	names := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
 		names = append(names, ns.Name)
 	}
 	sort.Strings(names)
@@ -2017,6 +2554,15 @@ func getNextNamespace(k8sClient *kubernetes.Clientset, current string) string {
 	return names[0]
 }
 
+// formatUsageRatio is formatResourceRatio for usage cells; it returns n/a
+// when usage metrics are unavailable.
+func formatUsageRatio(used, total *resource.Quantity, isMemory, showRaw, available bool) string {
+	if !available {
+		return usageNotAvailable
+	}
+	return formatResourceRatio(used, total, isMemory, showRaw)
+}
+
 // formatResourceRatio formats CPU or memory as "used / total" ratio
 // For CPU: "10.2 / 17" (cores)
 // For memory: "44.7Gi / 66Gi" (binary units)
@@ -2138,11 +2684,15 @@ func addProgressBars(
 				// CPU Allocated bar
 				bars[resourceStartCol] = makeProgressBar(m.CPURequest, m.CPUCapacity, 10, showPercentages)
 				// CPU Usage bar
-				bars[resourceStartCol+1] = makeProgressBar(m.CPUUsage, m.CPUCapacity, 10, showPercentages)
+				if !m.UsageUnknown {
+					bars[resourceStartCol+1] = makeProgressBar(m.CPUUsage, m.CPUCapacity, 10, showPercentages)
+				}
 				// Memory Allocated bar
 				bars[resourceStartCol+2] = makeProgressBar(m.MemRequest, m.MemCapacity, 10, showPercentages)
 				// Memory Usage bar
-				bars[resourceStartCol+3] = makeProgressBar(m.MemUsage, m.MemCapacity, 10, showPercentages)
+				if !m.UsageUnknown {
+					bars[resourceStartCol+3] = makeProgressBar(m.MemUsage, m.MemCapacity, 10, showPercentages)
+				}
 			}
 		}
 
@@ -2218,6 +2768,9 @@ func getContextAndCluster(gc *GlanceConfig) (contextName, clusterName string) {
 	}
 
 	ctxName := rawConfig.CurrentContext
+	if gc.configFlags.Context != nil && *gc.configFlags.Context != "" {
+		ctxName = *gc.configFlags.Context
+	}
 	if ctxName != "" {
 		contextName = ctxName
 	}
@@ -2347,8 +2900,8 @@ func buildSettingsRows(state *LiveState) [][]string {
 		{"", "Sort by Memory", sortModeRadio(state.pendingSortMode, SortByMemory)},
 		{},
 		{"[Limits](fg:cyan,mod:bold)", "", ""},
-		{"", "Node Limit (←/→ adjust)", fmt.Sprintf("%d", state.pendingNodeLimit)},
-		{"", "Pod Limit (←/→ adjust)", fmt.Sprintf("%d", state.pendingPodLimit)},
+		{"", "Node Limit (←/→ adjust)", limitValue(state.pendingNodeLimit)},
+		{"", "Pod Limit (←/→ adjust)", limitValue(state.pendingPodLimit)},
 		{},
 		{"[Filters](fg:cyan,mod:bold)", "", ""},
 		{"", "Node Group Filter", filterValue(state.pendingFilterNodeGroup)},
@@ -2357,6 +2910,14 @@ func buildSettingsRows(state *LiveState) [][]string {
 	return rows
 }
 
+// limitValue formats a node or pod limit, where 0 means no limit.
+func limitValue(limit int) string {
+	if limit <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d", limit)
+}
+
 // boolToCheckbox converts boolean to checkbox symbol.
 func boolToCheckbox(b bool) string {
 	if b {
@@ -2601,20 +3162,21 @@ func adjustModalLimit(state *LiveState, row []string, delta int) {
 		return
 	}
 
-	switch row[1] {
-	case "Node Limit":
+	// Limits step down to 0, which means no limit.
+	switch {
+	case strings.HasPrefix(row[1], "Node Limit"):
 		state.pendingNodeLimit += delta
-		if state.pendingNodeLimit < 10 {
-			state.pendingNodeLimit = 10
+		if state.pendingNodeLimit < 0 {
+			state.pendingNodeLimit = 0
 		}
 		if state.pendingNodeLimit > 1000 {
 			state.pendingNodeLimit = 1000
 		}
 		state.modalDirty = true
-	case "Pod Limit":
+	case strings.HasPrefix(row[1], "Pod Limit"):
 		state.pendingPodLimit += delta
-		if state.pendingPodLimit < 10 {
-			state.pendingPodLimit = 10
+		if state.pendingPodLimit < 0 {
+			state.pendingPodLimit = 0
 		}
 		if state.pendingPodLimit > 10000 {
 			state.pendingPodLimit = 10000
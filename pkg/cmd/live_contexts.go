@@ -0,0 +1,308 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+)
+
+// liveTab is one cluster open in the live TUI. Each tab keeps its own
+// LiveState, so view mode, namespace, sort order and cloud cache do not leak
+// between clusters.
+type liveTab struct {
+	contextName string
+	client      *kubernetes.Clientset
+	gc          *GlanceConfig
+	state       *LiveState
+}
+
+// liveTabs tracks the open cluster tabs and the context picker popup.
+type liveTabs struct {
+	tabs   []*liveTab
+	active int
+	// open connects to a kubeconfig context and returns its tab.
+	open func(contextName string) (*liveTab, error)
+	// contexts lists the kubeconfig contexts offered by the picker.
+	contexts []string
+	// Context picker popup state
+	showPicker  bool
+	pickerIndex int
+	pickerErr   string
+}
+
+// newLiveTabs returns tabs holding the initial cluster.
+func newLiveTabs(first *liveTab, open func(contextName string) (*liveTab, error)) *liveTabs {
+	return &liveTabs{tabs: []*liveTab{first}, open: open}
+}
+
+// current returns the active tab.
+func (t *liveTabs) current() *liveTab {
+	return t.tabs[t.active]
+}
+
+// find returns the index of the tab for contextName, or -1.
+func (t *liveTabs) find(contextName string) int {
+	for i, tab := range t.tabs {
+		if tab.contextName == contextName {
+			return i
+		}
+	}
+	return -1
+}
+
+// openContext focuses the tab for contextName, connecting to the cluster in
+// a new tab if it is not open yet.
+func (t *liveTabs) openContext(contextName string) error {
+	if i := t.find(contextName); i >= 0 {
+		t.active = i
+		return nil
+	}
+	tab, err := t.open(contextName)
+	if err != nil {
+		return err
+	}
+	tab.contextName = contextName
+	t.tabs = append(t.tabs, tab)
+	t.active = len(t.tabs) - 1
+	return nil
+}
+
+// next focuses the next tab, wrapping around.
+func (t *liveTabs) next() {
+	t.active = (t.active + 1) % len(t.tabs)
+}
+
+// closeCurrent closes the active tab unless it is the last one.
+func (t *liveTabs) closeCurrent() {
+	if len(t.tabs) <= 1 {
+		return
+	}
+	if cache := t.current().state.cache; cache != nil {
+		cache.Stop()
+	}
+	t.tabs = append(t.tabs[:t.active], t.tabs[t.active+1:]...)
+	if t.active >= len(t.tabs) {
+		t.active = len(t.tabs) - 1
+	}
+}
+
+// stop stops the informer caches of all tabs.
+func (t *liveTabs) stop() {
+	for _, tab := range t.tabs {
+		if tab.state.cache != nil {
+			tab.state.cache.Stop()
+		}
+	}
+}
+
+// title renders the tab strip shown in the table border, e.g.
+// " staging │ [prod] ". A single tab shows no strip.
+func (t *liveTabs) title() string {
+	if len(t.tabs) <= 1 {
+		return ""
+	}
+	names := make([]string, len(t.tabs))
+	for i, tab := range t.tabs {
+		if i == t.active {
+			names[i] = "[" + tab.contextName + "]"
+		} else {
+			names[i] = tab.contextName
+		}
+	}
+	return " " + strings.Join(names, " │ ") + " "
+}
+
+// handleKey applies a key to the tabs or the context picker. It returns
+// false for keys that belong to the active cluster's view, including every
+// key typed into the filter input.
+func (t *liveTabs) handleKey(id string) bool {
+	state := t.current().state
+	if state.showSettingsModal || state.showConfirmDiscard || state.filterEditing || id == interruptKey {
+		return false
+	}
+
+	action := state.keyMap().action(id)
+	if t.showPicker {
+		switch {
+		case action == actionBack || action == actionContexts || action == actionQuit:
+			t.showPicker = false
+		case action == actionUp:
+			if t.pickerIndex > 0 {
+				t.pickerIndex--
+			}
+		case action == actionDown:
+			if t.pickerIndex < len(t.contexts)-1 {
+				t.pickerIndex++
+			}
+		case action == actionDetails:
+			if t.pickerIndex < len(t.contexts) {
+				if err := t.openContext(t.contexts[t.pickerIndex]); err != nil {
+					log.Errorf("Failed to open context %s: %v", t.contexts[t.pickerIndex], err)
+					t.pickerErr = err.Error()
+					return true
+				}
+				t.showPicker = false
+			}
+		}
+		return true
+	}
+
+	switch action {
+	case actionContexts:
+		t.showPicker = true
+		t.pickerErr = ""
+		t.pickerIndex = 0
+		for i, name := range t.contexts {
+			if name == t.current().contextName {
+				t.pickerIndex = i
+			}
+		}
+	case actionNextTab:
+		t.next()
+	case actionCloseTab:
+		t.closeCurrent()
+	default:
+		return false
+	}
+	return true
+}
+
+// handleEvent handles tab and picker keys, redrawing as needed. It returns
+// false when the event should go to the active cluster's view.
+func (t *liveTabs) handleEvent(e ui.Event) bool {
+	if !t.handleKey(e.ID) {
+		return false
+	}
+	if err := t.updateDisplay(); err != nil {
+		log.Errorf("Failed to update display: %v", err)
+	}
+	return true
+}
+
+// updateDisplay refreshes the active tab and draws the picker on top.
+func (t *liveTabs) updateDisplay() error {
+	cur := t.current()
+	cur.state.table.Title = t.title()
+	err := updateDisplay(cur.client, cur.gc, cur.state)
+	if t.showPicker {
+		termWidth, termHeight := ui.TerminalDimensions()
+		ui.Render(t.createContextPicker(termWidth, termHeight))
+	}
+	return err
+}
+
+// createContextPicker creates the centered kubeconfig context list. Open
+// contexts are marked with ● and the active one with ▶.
+func (t *liveTabs) createContextPicker(termWidth, termHeight int) *widgets.List {
+	picker := widgets.NewList()
+	keys := t.current().state.keyMap()
+	picker.Title = fmt.Sprintf(" Contexts - %s%s Select | %s Open | %s Close ",
+		keys.label(actionUp), keys.label(actionDown), keys.label(actionDetails), keys.label(actionBack))
+	if t.pickerErr != "" {
+		picker.Title = fmt.Sprintf(" Error: %s ", t.pickerErr)
+	}
+	picker.TitleStyle = ui.NewStyle(ui.ColorCyan, ui.ColorBlack, ui.ModifierBold)
+	picker.BorderStyle = ui.NewStyle(ui.ColorCyan)
+	picker.SelectedRowStyle = ui.NewStyle(ui.ColorBlack, ui.ColorCyan, ui.ModifierBold)
+	picker.WrapText = false
+
+	rows := make([]string, len(t.contexts))
+	for i, name := range t.contexts {
+		marker := "  "
+		if name == t.current().contextName {
+			marker = "▶ "
+		} else if t.find(name) >= 0 {
+			marker = "● "
+		}
+		rows[i] = marker + name
+	}
+	if len(rows) == 0 {
+		rows = []string{"  (no contexts in kubeconfig)"}
+	}
+	picker.Rows = rows
+	picker.SelectedRow = t.pickerIndex
+
+	width := min(60, termWidth-4)
+	height := min(len(rows)+2, termHeight-4)
+	x := (termWidth - width) / 2
+	y := (termHeight - height) / 2
+	picker.SetRect(x, y, x+width, y+height)
+
+	return picker
+}
+
+// openLiveTab connects to a kubeconfig context and returns a tab whose
+// state starts from the display settings of template.
+func openLiveTab(base *GlanceConfig, contextName string, template *LiveState) (*liveTab, error) {
+	cgc, err := contextGlanceConfig(base, contextName)
+	if err != nil {
+		return nil, err
+	}
+	k8sClient, err := kubernetes.NewForConfig(cgc.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+	metricsMode, metricsSource, err := resolveMetricsSource(cgc)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, _, err := cgc.configFlags.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		log.Debugf("Failed to determine namespace for context %s: %v", contextName, err)
+		namespace = ""
+	}
+
+	state := newLiveState(template.refreshInterval, template.nodeLimit, template.podLimit,
+		template.maxConcurrent, template.sortMode, namespace, metricsSource, metricsMode)
+	state.showBars = template.showBars
+	state.showPercentages = template.showPercentages
+	state.compactMode = template.compactMode
+	state.showRawResources = template.showRawResources
+	state.keys = template.keys
+	state.table, state.statusBar, state.menuBar = template.table, template.statusBar, template.menuBar
+	// Tabs opened from a --watch tab watch their cluster too.
+	if template.cache != nil {
+		if state.cache, err = startLiveWatch(k8sClient); err != nil {
+			return nil, err
+		}
+	}
+	detectLiveCluster(k8sClient, cgc, state)
+
+	return &liveTab{contextName: contextName, client: k8sClient, gc: cgc, state: state}, nil
+}
+
+// kubeconfigContexts lists the kubeconfig contexts in name order.
+func kubeconfigContexts(gc *GlanceConfig) []string {
+	if gc == nil || gc.configFlags == nil {
+		return nil
+	}
+	raw, err := gc.configFlags.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		log.Debugf("Unable to list kubeconfig contexts: %v", err)
+		return nil
+	}
+	contexts, err := resolveFleetContexts(raw, nil, true)
+	if err != nil {
+		log.Debugf("Unable to list kubeconfig contexts: %v", err)
+		return nil
+	}
+	return contexts
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"testing"
+)
+
+func newTestLiveTabs(opened *[]string) *liveTabs {
+	first := &liveTab{contextName: "staging", state: &LiveState{mode: ViewPods, selectedNamespace: "payments"}}
+	tabs := newLiveTabs(first, func(name string) (*liveTab, error) {
+		if name == "broken" {
+			return nil, fmt.Errorf("connection refused")
+		}
+		*opened = append(*opened, name)
+		return &liveTab{state: &LiveState{mode: ViewNodes}}, nil
+	})
+	tabs.contexts = []string{"broken", "prod", "staging"}
+	return tabs
+}
+
+func TestLiveTabs_PickerOpensAndFocusesTabs(t *testing.T) {
+	var opened []string
+	tabs := newTestLiveTabs(&opened)
+
+	if !tabs.handleKey("C") || !tabs.showPicker {
+		t.Fatal("expected C to open the context picker")
+	}
+	if tabs.pickerIndex != 2 {
+		t.Errorf("expected picker to start on the current context, got index %d", tabs.pickerIndex)
+	}
+
+	tabs.handleKey("<Up>")
+	tabs.handleKey("<Enter>")
+	if tabs.showPicker || tabs.current().contextName != "prod" || len(tabs.tabs) != 2 {
+		t.Fatalf("expected prod to open in a new tab, got active=%q tabs=%d", tabs.current().contextName, len(tabs.tabs))
+	}
+	if tabs.title() != " staging │ [prod] " {
+		t.Errorf("unexpected tab strip %q", tabs.title())
+	}
+
+	// Per-cluster state is kept separate.
+	tabs.current().state.mode = ViewDeployments
+	tabs.handleKey("<Tab>")
+	if s := tabs.current().state; s.mode != ViewPods || s.selectedNamespace != "payments" {
+		t.Errorf("expected staging tab to keep its own view, got mode=%v ns=%q", s.mode, s.selectedNamespace)
+	}
+
+	// Picking an open context focuses it rather than reconnecting.
+	tabs.handleKey("C")
+	tabs.handleKey("<Up>")
+	tabs.handleKey("<Enter>")
+	if tabs.current().contextName != "prod" || len(opened) != 1 {
+		t.Errorf("expected prod tab to be focused without reconnecting, opened %v", opened)
+	}
+}
+
+func TestLiveTabs_OpenErrorKeepsPicker(t *testing.T) {
+	var opened []string
+	tabs := newTestLiveTabs(&opened)
+
+	tabs.handleKey("C")
+	tabs.handleKey("<Up>")
+	tabs.handleKey("<Up>")
+	tabs.handleKey("<Enter>")
+	if !tabs.showPicker || tabs.pickerErr == "" {
+		t.Error("expected picker to stay open with the connection error")
+	}
+	if len(tabs.tabs) != 1 || tabs.current().contextName != "staging" {
+		t.Error("expected current tab to be unchanged after a failed open")
+	}
+
+	tabs.handleKey("<Escape>")
+	if tabs.showPicker {
+		t.Error("expected Esc to close the picker")
+	}
+}
+
+func TestLiveTabs_CloseAndPassThrough(t *testing.T) {
+	var opened []string
+	tabs := newTestLiveTabs(&opened)
+
+	tabs.handleKey("X")
+	if len(tabs.tabs) != 1 {
+		t.Error("expected the last tab to stay open")
+	}
+	if tabs.title() != "" {
+		t.Errorf("expected no tab strip with a single tab, got %q", tabs.title())
+	}
+
+	_ = tabs.openContext("prod")
+	tabs.handleKey("X")
+	if len(tabs.tabs) != 1 || tabs.current().contextName != "staging" {
+		t.Errorf("expected closing prod to return to staging, got %q", tabs.current().contextName)
+	}
+
+	if tabs.handleKey("p") {
+		t.Error("expected view keys to pass through to the active tab")
+	}
+	tabs.current().state.filterEditing = true
+	for _, key := range []string{"C", "X", "<Tab>"} {
+		if tabs.handleKey(key) || tabs.showPicker {
+			t.Errorf("expected %s to go to the filter input while it is edited", key)
+		}
+	}
+	tabs.current().state.filterEditing = false
+	tabs.current().state.showSettingsModal = true
+	if tabs.handleKey("C") {
+		t.Error("expected tab keys to be ignored while the settings modal is open")
+	}
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	ui "github.com/gizak/termui/v3"
+)
+
+// Fields of a live filter expression. Any other field name is a label key.
+const (
+	filterFieldName      = "name"
+	filterFieldNamespace = "ns"
+	filterFieldStatus    = "status"
+	filterFieldNode      = "node"
+	filterFieldCPU       = "cpu"    // usage, percent of capacity
+	filterFieldMemory    = "mem"    // usage, percent of capacity
+	filterFieldCPUReq    = "cpureq" // requests, percent of capacity
+	filterFieldMemReq    = "memreq" // requests, percent of capacity
+)
+
+// filterFieldAliases maps alternative spellings to field names.
+var filterFieldAliases = map[string]string{
+	"namespace": filterFieldNamespace,
+	"memory":    filterFieldMemory,
+}
+
+// filterOperators are tried in order, so two-character operators win.
+var filterOperators = []string{">=", "<=", "!=", ">", "<", "="}
+
+// liveFilterRow is what a filter is matched against: the identity and
+// labels of a table row and the figures behind its bars.
+type liveFilterRow struct {
+	name      string
+	namespace string
+	status    string
+	node      string
+	labels    map[string]string
+	metrics   *ResourceMetrics // nil when the view has no figures
+}
+
+// liveFilterTerm is one whitespace-separated term of an expression. Terms
+// without an operator are free text.
+type liveFilterTerm struct {
+	field  string
+	op     string
+	value  string // lowercased
+	number float64
+}
+
+// liveFilter is a parsed filter expression; all terms must match.
+type liveFilter struct {
+	terms []liveFilterTerm
+}
+
+// parseLiveFilter parses an expression such as
+// "api cpu>80 status!=Ready ns=pay*". Free text matches names, namespaces
+// and labels as a case-insensitive substring. name, ns, status, node and
+// label keys compare with = and != against a value that may contain *
+// wildcards; cpu, mem, cpureq and memreq compare percentages with = != > >=
+// < <=. An empty expression yields a nil filter, which matches every row.
+func parseLiveFilter(expr string) (*liveFilter, error) {
+	tokens := strings.Fields(expr)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	f := &liveFilter{terms: make([]liveFilterTerm, 0, len(tokens))}
+	for _, tok := range tokens {
+		i := strings.IndexAny(tok, "<>!=")
+		if i < 0 {
+			f.terms = append(f.terms, liveFilterTerm{value: strings.ToLower(tok)})
+			continue
+		}
+		var op string
+		for _, candidate := range filterOperators {
+			if strings.HasPrefix(tok[i:], candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return nil, fmt.Errorf("unknown operator in %q", tok)
+		}
+		field, value := strings.ToLower(tok[:i]), strings.ToLower(tok[i+len(op):])
+		if alias, ok := filterFieldAliases[field]; ok {
+			field = alias
+		}
+		if field == "" {
+			return nil, fmt.Errorf("missing field in %q", tok)
+		}
+		if value == "" {
+			return nil, fmt.Errorf("missing value in %q", tok)
+		}
+		term := liveFilterTerm{field: field, op: op, value: value}
+		if isPercentField(field) {
+			n, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s needs a number in %q", field, tok)
+			}
+			term.number = n
+		} else if op != "=" && op != "!=" {
+			return nil, fmt.Errorf("%s only supports = and != in %q", field, tok)
+		}
+		f.terms = append(f.terms, term)
+	}
+	return f, nil
+}
+
+func isPercentField(field string) bool {
+	switch field {
+	case filterFieldCPU, filterFieldMemory, filterFieldCPUReq, filterFieldMemReq:
+		return true
+	}
+	return false
+}
+
+// match reports whether r satisfies every term of f.
+func (f *liveFilter) match(r liveFilterRow) bool {
+	if f == nil {
+		return true
+	}
+	for _, t := range f.terms {
+		if !t.match(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t liveFilterTerm) match(r liveFilterRow) bool {
+	switch t.field {
+	case "":
+		if strings.Contains(strings.ToLower(r.name), t.value) ||
+			strings.Contains(strings.ToLower(r.namespace), t.value) {
+			return true
+		}
+		for k, v := range r.labels {
+			if strings.Contains(strings.ToLower(k+"="+v), t.value) {
+				return true
+			}
+		}
+		return false
+	case filterFieldName:
+		return t.matchString(r.name, true)
+	case filterFieldNamespace:
+		return t.matchString(r.namespace, true)
+	case filterFieldStatus:
+		return t.matchString(r.status, true)
+	case filterFieldNode:
+		return t.matchString(r.node, true)
+	case filterFieldCPU, filterFieldMemory, filterFieldCPUReq, filterFieldMemReq:
+		pct, ok := filterPercent(t.field, r.metrics)
+		if !ok {
+			return false
+		}
+		return t.compare(pct)
+	default:
+		v, ok := r.labels[t.field]
+		if !ok {
+			// Label keys are case-sensitive; the field was lowercased.
+			for k, lv := range r.labels {
+				if strings.EqualFold(k, t.field) {
+					v, ok = lv, true
+					break
+				}
+			}
+		}
+		return t.matchString(v, ok)
+	}
+}
+
+// matchString applies = or != to s; present is false for a missing label,
+// which never equals a value.
+func (t liveFilterTerm) matchString(s string, present bool) bool {
+	equal := false
+	if present {
+		equal, _ = path.Match(t.value, strings.ToLower(s))
+	}
+	if t.op == "!=" {
+		return !equal
+	}
+	return equal
+}
+
+func (t liveFilterTerm) compare(v float64) bool {
+	switch t.op {
+	case ">":
+		return v > t.number
+	case ">=":
+		return v >= t.number
+	case "<":
+		return v < t.number
+	case "<=":
+		return v <= t.number
+	case "!=":
+		return v != t.number
+	default:
+		return v == t.number
+	}
+}
+
+// filterPercent returns a figure of m as a percentage of its capacity, or
+// false when the view has no such figure or usage is unknown.
+func filterPercent(field string, m *ResourceMetrics) (float64, bool) {
+	if m == nil {
+		return 0, false
+	}
+	switch field {
+	case filterFieldCPU:
+		return safePercentage(m.CPUUsage, m.CPUCapacity), !m.UsageUnknown
+	case filterFieldMemory:
+		return safePercentage(m.MemUsage, m.MemCapacity), !m.UsageUnknown
+	case filterFieldCPUReq:
+		return safePercentage(m.CPURequest, m.CPUCapacity), true
+	default:
+		return safePercentage(m.MemRequest, m.MemCapacity), true
+	}
+}
+
+// setFilter parses expr and makes it the active filter. An invalid
+// expression is kept for editing but filters nothing until it is fixed.
+func (s *LiveState) setFilter(expr string) {
+	s.filterText = expr
+	s.filter, s.filterErr = parseLiveFilter(expr)
+}
+
+// liveFilterDelay is how long the live view waits after the last key typed
+// into the filter before refetching its rows, so that typing an expression
+// costs one refresh rather than one per key.
+const liveFilterDelay = 300 * time.Millisecond
+
+// handleFilterEvent edits the filter typed after "/". Enter keeps the
+// filter and closes the input; Esc clears it.
+func handleFilterEvent(e ui.Event, state *LiveState) {
+	switch e.ID {
+	case "<Enter>":
+		state.filterEditing = false
+	case "<Escape>":
+		state.filterEditing = false
+		state.setFilter("")
+	case "<Backspace>", "<C-<Backspace>>":
+		if _, size := utf8.DecodeLastRuneInString(state.filterText); size > 0 {
+			state.setFilter(state.filterText[:len(state.filterText)-size])
+		}
+	case "<C-u>":
+		state.setFilter("")
+	case "<Space>":
+		state.setFilter(state.filterText + " ")
+	default:
+		if e.Type == ui.KeyboardEvent && utf8.RuneCountInString(e.ID) == 1 {
+			state.setFilter(state.filterText + e.ID)
+		}
+	}
+}
+
+// filterStatusText describes the active filter for the status bar.
+func filterStatusText(state *LiveState) string {
+	if state.filterText == "" {
+		return ""
+	}
+	return " | Filter: " + escapeMarkup(state.filterText) + filterErrorText(state)
+}
+
+// filterInputText replaces the status bar while the filter is typed.
+func filterInputText(state *LiveState) string {
+	return fmt.Sprintf(" [/%s▏](fg:black,bg:cyan)%s | [Enter]Apply [Esc]Clear [C-u]Erase",
+		escapeMarkup(state.filterText), filterErrorText(state))
+}
+
+func filterErrorText(state *LiveState) string {
+	if state.filterErr == nil {
+		return ""
+	}
+	return " [" + escapeMarkup(state.filterErr.Error()) + "](fg:red)"
+}
+
+// escapeMarkup replaces brackets, which termui would read as style markup.
+func escapeMarkup(s string) string {
+	return strings.NewReplacer("[", "(", "]", ")").Replace(s)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+)
+
+func TestParseLiveFilterErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"cpu>", "missing value"},
+		{"=web", "missing field"},
+		{"cpu>high", "needs a number"},
+		{"ns>payments", "only supports = and !="},
+		{"ns!payments", "unknown operator"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			if _, err := parseLiveFilter(tt.expr); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("parseLiveFilter(%q) error = %v, want %q", tt.expr, err, tt.want)
+			}
+		})
+	}
+
+	f, err := parseLiveFilter("   ")
+	if f != nil || err != nil {
+		t.Errorf("expected a nil filter for a blank expression, got %v, %v", f, err)
+	}
+	if !f.match(liveFilterRow{}) {
+		t.Error("a nil filter must match every row")
+	}
+}
+
+func TestLiveFilterMatch(t *testing.T) {
+	api := liveFilterRow{
+		name: "api-7d9f-x1", namespace: "payments", status: "Running", node: "node-1",
+		labels:  map[string]string{"app": "api", "Tier": "Backend"},
+		metrics: &ResourceMetrics{CPUUsage: 0.9, CPURequest: 0.5, CPUCapacity: 1, MemUsage: 100, MemRequest: 200, MemCapacity: 400},
+	}
+	unknown := api
+	unknown.metrics = &ResourceMetrics{CPUUsage: 0, CPURequest: 0.5, CPUCapacity: 1, UsageUnknown: true}
+
+	tests := []struct {
+		expr string
+		row  liveFilterRow
+		want bool
+	}{
+		{"API", api, true},                // free text, case-insensitive
+		{"pay", api, true},                // namespace
+		{"backend", api, true},            // label key=value substring
+		{"worker", api, false},            // no match
+		{"ns=payments", api, true},        // field equality
+		{"namespace=pay*", api, true},     // alias and wildcard
+		{"ns!=payments", api, false},      // inequality
+		{"status!=Ready", api, true},      // Running != Ready
+		{"node=node-?", api, true},        // single-character wildcard
+		{"app=api", api, true},            // label field
+		{"tier=backend", api, true},       // label key and value case-insensitive
+		{"team!=core", api, true},         // missing label is never equal
+		{"team=core", api, false},         // missing label
+		{"cpu>80", api, true},             // usage 90%
+		{"cpu>=90%", api, true},           // percent suffix
+		{"cpureq<50", api, false},         // requests 50%
+		{"mem<=25 memreq=50", api, true},  // all terms must match
+		{"cpu>80 ns=default", api, false}, // one term fails
+		{"cpu<10", unknown, false},        // unknown usage never matches
+		{"cpureq=50", unknown, true},      // requests are still known
+		{"cpu>1", liveFilterRow{}, false}, // view without figures
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			f, err := parseLiveFilter(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.match(tt.row); got != tt.want {
+				t.Errorf("match(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterKeysDeferRefresh(t *testing.T) {
+	state := &LiveState{filterEditing: true, statusBar: widgets.NewParagraph()}
+
+	// Without a client, a refetch would panic: typing only redraws the input.
+	for _, id := range []string{"n", "s", "=", "a"} {
+		if handleUIEvent(ui.Event{Type: ui.KeyboardEvent, ID: id}, nil, nil, state) {
+			t.Fatalf("%s quit the live view", id)
+		}
+	}
+	if !state.filterDirty || !strings.Contains(state.statusBar.Text, "/ns=a▏") {
+		t.Errorf("expected a pending refresh and the typed filter, got dirty=%v %q", state.filterDirty, state.statusBar.Text)
+	}
+}
+
+func TestHandleFilterEvent(t *testing.T) {
+	state := &LiveState{filterEditing: true}
+	key := func(id string) { handleFilterEvent(ui.Event{Type: ui.KeyboardEvent, ID: id}, state) }
+
+	for _, id := range []string{"c", "p", "u", ">", "<Space>", "a", "<Backspace>"} {
+		key(id)
+	}
+	if state.filterText != "cpu> " || state.filterErr == nil || state.filter != nil {
+		t.Fatalf("expected an incomplete expression, got %q, %v", state.filterText, state.filterErr)
+	}
+	if !strings.Contains(filterInputText(state), "/cpu> ▏") || !strings.Contains(filterInputText(state), "missing value") {
+		t.Errorf("unexpected input text %q", filterInputText(state))
+	}
+
+	key("<C-u>")
+	for _, id := range []string{"q", "<Tab>", "<F1>", "é"} {
+		key(id)
+	}
+	if state.filterText != "qé" || state.filter == nil || !state.filterEditing {
+		t.Fatalf("expected only runes to be typed, got %q", state.filterText)
+	}
+
+	key("<Enter>")
+	if state.filterEditing || state.filterText != "qé" || filterStatusText(state) != " | Filter: qé" {
+		t.Errorf("expected Enter to keep the filter, got %q editing=%v", state.filterText, state.filterEditing)
+	}
+
+	state.mode = ViewNodes
+	handleEscapeKey(state)
+	if state.filterText != "" || state.filter != nil || filterStatusText(state) != "" {
+		t.Errorf("expected Esc to clear the filter, got %q", state.filterText)
+	}
+}
+
+func TestFilterNodeDataExpression(t *testing.T) {
+	data := []nodeRowData{
+		{row: []string{"node-a"}, isReady: true, labels: map[string]string{"pool": "general"},
+			metrics: ResourceMetrics{CPUUsage: 3.6, CPUCapacity: 4}},
+		{row: []string{"node-b"}, isReady: false, labels: map[string]string{"pool": "general"},
+			metrics: ResourceMetrics{CPUUsage: 1, CPUCapacity: 4}},
+		{row: []string{"node-c"}, isReady: true, labels: map[string]string{"pool": "gpu"},
+			metrics: ResourceMetrics{CPUUsage: 3.9, CPUCapacity: 4}},
+	}
+
+	state := &LiveState{}
+	state.setFilter("pool=general")
+	if got := filterNodeData(data, state); len(got) != 2 {
+		t.Errorf("expected 2 general nodes, got %d", len(got))
+	}
+	state.setFilter("status!=Ready")
+	if got := filterNodeData(data, state); len(got) != 1 || got[0].row[0] != "node-b" {
+		t.Errorf("expected node-b only, got %v", got)
+	}
+	state.setFilter("cpu>80")
+	state.filterCapacityType = "SPOT"
+	if got := filterNodeData(data, state); len(got) != 0 {
+		t.Errorf("expected the capacity filter to still apply, got %v", got)
+	}
+}
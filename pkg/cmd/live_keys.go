@@ -0,0 +1,375 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/viper"
+)
+
+// Actions of the live view that can be bound to keys in the "keybindings"
+// section of ~/.glance/config.
+const (
+	actionViewNodes         = "view-nodes"
+	actionViewNamespaces    = "view-namespaces"
+	actionViewPods          = "view-pods"
+	actionViewDeployments   = "view-deployments"
+	actionViewPending       = "view-pending"
+	actionToggleBars        = "toggle-bars"
+	actionTogglePercentages = "toggle-percentages"
+	actionToggleCompact     = "toggle-compact"
+	actionToggleRaw         = "toggle-raw"
+	actionToggleGPU         = "toggle-gpu"
+	actionToggleCloud       = "toggle-cloud"
+	actionToggleVersion     = "toggle-version"
+	actionToggleAge         = "toggle-age"
+	actionToggleGroup       = "toggle-group"
+	actionSortStatus        = "sort-status"
+	actionSortName          = "sort-name"
+	actionSortCPU           = "sort-cpu"
+	actionSortMemory        = "sort-memory"
+	actionSettings          = "settings"
+	actionQuit              = "quit"
+	actionUp                = "up"
+	actionDown              = "down"
+	actionLeft              = "left"
+	actionRight             = "right"
+	actionPageUp            = "page-up"
+	actionPageDown          = "page-down"
+	actionHome              = "home"
+	actionEnd               = "end"
+	actionExpand            = "expand"
+	actionDetails           = "details"
+	actionBack              = "back"
+	actionFilter            = "filter"
+	actionContexts          = "contexts"
+	actionNextTab           = "next-tab"
+	actionCloseTab          = "close-tab"
+)
+
+// defaultKeyBindings are the keys of each action when the config does not
+// rebind it. Keys are termui event IDs: single characters, or names such as
+// <Up>, <PageDown>, <Enter>, <Escape>, <Tab>, <Space>, <F5> and <C-c>.
+var defaultKeyBindings = []struct {
+	action string
+	keys   []string
+}{
+	{actionViewNodes, []string{"o"}},
+	{actionViewNamespaces, []string{"n"}},
+	{actionViewPods, []string{"p"}},
+	{actionViewDeployments, []string{"d"}},
+	{actionViewPending, []string{"P"}},
+	{actionToggleBars, []string{"b"}},
+	{actionTogglePercentages, []string{"%"}},
+	{actionToggleCompact, []string{"c"}},
+	{actionToggleRaw, []string{"r"}},
+	{actionToggleGPU, []string{"u"}},
+	{actionToggleCloud, []string{"w"}},
+	{actionToggleVersion, []string{"v"}},
+	{actionToggleAge, []string{"a"}},
+	{actionToggleGroup, []string{"g"}},
+	{actionSortStatus, []string{"1"}},
+	{actionSortName, []string{"2"}},
+	{actionSortCPU, []string{"3"}},
+	{actionSortMemory, []string{"4"}},
+	{actionSettings, []string{"?", "h"}},
+	{actionQuit, []string{"q", "<C-c>"}},
+	{actionUp, []string{"<Up>"}},
+	{actionDown, []string{"<Down>"}},
+	{actionLeft, []string{"<Left>"}},
+	{actionRight, []string{"<Right>"}},
+	{actionPageUp, []string{"<PageUp>"}},
+	{actionPageDown, []string{"<PageDown>"}},
+	{actionHome, []string{"<Home>"}},
+	{actionEnd, []string{"<End>"}},
+	{actionExpand, []string{"e"}},
+	{actionDetails, []string{"<Enter>"}},
+	{actionBack, []string{"<Escape>"}},
+	{actionFilter, []string{"/"}},
+	{actionContexts, []string{"C"}},
+	{actionNextTab, []string{"<Tab>"}},
+	{actionCloseTab, []string{"X"}},
+}
+
+// liveKeyMap maps keys to live view actions and back.
+type liveKeyMap struct {
+	keys    map[string][]string // action -> keys, the first one shown in the menu
+	actions map[string]string   // key -> action
+}
+
+// interruptKey is bound to quit whatever the keybindings say.
+const interruptKey = "<C-c>"
+
+// defaultLiveKeys is the key map used when none was loaded.
+var defaultLiveKeys, _ = newLiveKeyMap(nil)
+
+// loadLiveKeyMap builds the key map from the "keybindings" section of
+// ~/.glance/config.
+func loadLiveKeyMap() (*liveKeyMap, error) {
+	return newLiveKeyMap(viper.GetStringMap("keybindings"))
+}
+
+// newLiveKeyMap returns the default key map with the actions in bindings
+// rebound. Each binding is a key or a list of keys and replaces the
+// action's default keys; an empty list leaves the action unbound. <C-c> is
+// always bound to quit. Unknown actions, invalid keys and keys bound to two
+// actions are errors.
+func newLiveKeyMap(bindings map[string]any) (*liveKeyMap, error) {
+	m := &liveKeyMap{keys: make(map[string][]string), actions: make(map[string]string)}
+	for _, b := range defaultKeyBindings {
+		m.keys[b.action] = b.keys
+	}
+
+	for action, value := range bindings {
+		if _, ok := m.keys[action]; !ok {
+			return nil, fmt.Errorf("keybindings: unknown action %q", action)
+		}
+		keys, err := bindingKeys(value)
+		if err != nil {
+			return nil, fmt.Errorf("keybindings: %s: %w", action, err)
+		}
+		m.keys[action] = keys
+	}
+	// Ctrl-C always quits, so a rebound quit key cannot leave the view
+	// without a way out.
+	if !slices.Contains(m.keys[actionQuit], interruptKey) {
+		m.keys[actionQuit] = append(slices.Clip(m.keys[actionQuit]), interruptKey)
+	}
+
+	// Walk the actions in their default order so that conflicts are
+	// reported the same way on every start.
+	for _, b := range defaultKeyBindings {
+		for _, key := range m.keys[b.action] {
+			if other, ok := m.actions[key]; ok && other != b.action {
+				return nil, fmt.Errorf("keybindings: %s is bound to both %s and %s", key, other, b.action)
+			}
+			m.actions[key] = b.action
+		}
+	}
+	return m, nil
+}
+
+// bindingKeys returns the keys of one binding, a string or a list of strings.
+func bindingKeys(value any) ([]string, error) {
+	var raw []string
+	switch v := value.(type) {
+	case string:
+		raw = []string{v}
+	case []string:
+		raw = v
+	case []any:
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("key %v is not a string", item)
+			}
+			raw = append(raw, s)
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("expected a key or a list of keys, got %v", value)
+	}
+
+	keys := make([]string, 0, len(raw))
+	for _, key := range raw {
+		if key == " " {
+			key = "<Space>"
+		}
+		named := len(key) > 2 && strings.HasPrefix(key, "<") && strings.HasSuffix(key, ">")
+		if !named && utf8.RuneCountInString(key) != 1 {
+			return nil, fmt.Errorf("invalid key %q (use one character or a key name such as <F5>, <PageUp> or <C-a>)", key)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// keyMap returns the key map of the live view.
+func (s *LiveState) keyMap() *liveKeyMap {
+	if s.keys == nil {
+		return defaultLiveKeys
+	}
+	return s.keys
+}
+
+// action returns the action bound to key, or "" when there is none.
+func (m *liveKeyMap) action(key string) string {
+	return m.actions[key]
+}
+
+// key returns the first key bound to action, or "" when it is unbound.
+func (m *liveKeyMap) key(action string) string {
+	if keys := m.keys[action]; len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// label returns the first key bound to action as shown in the menu, e.g.
+// "PgUp" for <PageUp> and "Ctrl-a" for <C-a>.
+func (m *liveKeyMap) label(action string) string {
+	key := m.key(action)
+	switch key {
+	case "<Up>":
+		return "↑"
+	case "<Down>":
+		return "↓"
+	case "<Left>":
+		return "←"
+	case "<Right>":
+		return "→"
+	case "<PageUp>":
+		return "PgUp"
+	case "<PageDown>":
+		return "PgDn"
+	case "<Escape>":
+		return "Esc"
+	}
+	if strings.HasPrefix(key, "<C-") && len(key) > 4 {
+		return "Ctrl-" + key[3:len(key)-1]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(key, "<"), ">")
+}
+
+// hints returns "[key]name" for each action and name pair that is bound,
+// separated by spaces, for the status bar.
+func (m *liveKeyMap) hints(actionNames ...string) string {
+	var hints []string
+	for i := 0; i+1 < len(actionNames); i += 2 {
+		if m.key(actionNames[i]) != "" {
+			hints = append(hints, "["+m.label(actionNames[i])+"]"+actionNames[i+1])
+		}
+	}
+	return strings.Join(hints, " ")
+}
+
+// liveMenuItem is one entry of the live menu bar, shown as "[keys]name"
+// with the first key of each action. Items with one action can be
+// clicked; items whose actions are all unbound are left out.
+type liveMenuItem struct {
+	actions []string
+	name    string
+}
+
+// liveMenuGroup is a run of menu items, optionally titled ("Views: ...").
+type liveMenuGroup struct {
+	title string
+	items []liveMenuItem
+}
+
+// liveMenu is the two-line menu bar of the live view.
+var liveMenu = [][]liveMenuGroup{
+	{
+		{title: "Views", items: []liveMenuItem{
+			{[]string{actionViewNodes}, "Nodes"}, {[]string{actionViewNamespaces}, "Namespaces"},
+			{[]string{actionViewPods}, "Pods"}, {[]string{actionViewDeployments}, "Deployments"},
+			{[]string{actionViewPending}, "Pending"},
+		}},
+		{title: "Toggle", items: []liveMenuItem{
+			{[]string{actionToggleBars}, "Bars"}, {[]string{actionTogglePercentages}, "Percent"},
+			{[]string{actionToggleRaw}, "Raw"}, {[]string{actionToggleGPU}, "GPU"},
+			{[]string{actionToggleCloud}, "Cloud"}, {[]string{actionToggleVersion}, "Version"},
+			{[]string{actionToggleAge}, "Age"}, {[]string{actionToggleGroup}, "Group"},
+		}},
+	},
+	{
+		{title: "Sort", items: []liveMenuItem{
+			{[]string{actionSortStatus}, "Status"}, {[]string{actionSortName}, "Name"},
+			{[]string{actionSortCPU}, "CPU"}, {[]string{actionSortMemory}, "Memory"},
+		}},
+		{items: []liveMenuItem{
+			{[]string{actionUp, actionDown}, "Select"},
+			{[]string{actionPageUp, actionPageDown, actionHome, actionEnd}, "Scroll"},
+			{[]string{actionExpand}, "Containers"}, {[]string{actionDetails}, "Details"},
+			{[]string{actionBack}, "Back"}, {[]string{actionFilter}, "Filter"},
+		}},
+		{title: "Clusters", items: []liveMenuItem{
+			{[]string{actionContexts}, "Contexts"}, {[]string{actionNextTab}, "Next"},
+			{[]string{actionCloseTab}, "Close"},
+		}},
+		{items: []liveMenuItem{{[]string{actionSettings}, "Settings"}, {[]string{actionQuit}, "Quit"}}},
+	},
+}
+
+// menuLayout walks the menu bar text for keys, calling visit with the key
+// sent by clicking each item ("" when it cannot be clicked) and the column
+// range [start, end) it occupies on its line. It returns the text.
+func menuLayout(menu [][]liveMenuGroup, keys *liveKeyMap, visit func(line, start, end int, key string)) string {
+	lines := make([]string, len(menu))
+	for l, groups := range menu {
+		var b strings.Builder
+		b.WriteString(" ")
+		shown := 0
+		for _, group := range groups {
+			started := false
+			for _, item := range group.items {
+				var labels []string
+				for _, action := range item.actions {
+					if keys.key(action) != "" {
+						labels = append(labels, keys.label(action))
+					}
+				}
+				if len(labels) == 0 {
+					continue
+				}
+				switch {
+				case started:
+					b.WriteString(" ")
+				case shown > 0:
+					b.WriteString(" | ")
+				}
+				if !started && group.title != "" {
+					b.WriteString(group.title + ": ")
+				}
+				started = true
+
+				start := utf8.RuneCountInString(b.String())
+				b.WriteString("[" + strings.Join(labels, "/") + "]" + item.name)
+				if visit != nil {
+					key := ""
+					if len(item.actions) == 1 {
+						key = keys.key(item.actions[0])
+					}
+					visit(l, start, utf8.RuneCountInString(b.String()), key)
+				}
+			}
+			if started {
+				shown++
+			}
+		}
+		lines[l] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// menuText returns the menu bar text for keys.
+func menuText(menu [][]liveMenuGroup, keys *liveKeyMap) string {
+	return menuLayout(menu, keys, nil)
+}
+
+// menuKeyAt returns the key of the clickable menu item at column x of line,
+// or "" when there is none.
+func menuKeyAt(menu [][]liveMenuGroup, keys *liveKeyMap, line, x int) string {
+	key := ""
+	menuLayout(menu, keys, func(l, start, end int, k string) {
+		if l == line && x >= start && x < end {
+			key = k
+		}
+	})
+	return key
+}
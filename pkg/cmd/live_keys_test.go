@@ -0,0 +1,185 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/spf13/viper"
+)
+
+func TestLoadLiveKeyMap(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	keys, err := loadLiveKeyMap()
+	if err != nil {
+		t.Fatalf("loadLiveKeyMap() without config error = %v", err)
+	}
+	if keys.action("q") != actionQuit || keys.action("<C-c>") != actionQuit || keys.action("h") != actionSettings {
+		t.Error("expected the default bindings without a keybindings section")
+	}
+
+	viper.SetConfigType("yaml")
+	config := `
+keybindings:
+  up: [k, <Up>]
+  down: [j, <Down>]
+  settings: "?"
+  toggle-group: G
+  next-tab: []
+  quit: <C-q>
+`
+	if err := viper.ReadConfig(strings.NewReader(config)); err != nil {
+		t.Fatal(err)
+	}
+	keys, err = loadLiveKeyMap()
+	if err != nil {
+		t.Fatalf("loadLiveKeyMap() error = %v", err)
+	}
+	tests := map[string]string{
+		"k":     actionUp,
+		"<Up>":  actionUp,
+		"j":     actionDown,
+		"G":     actionToggleGroup,
+		"g":     "",
+		"h":     "",
+		"<Tab>": "",
+		"q":     "",
+		"<C-q>": actionQuit,
+		"<C-c>": actionQuit,
+		"o":     actionViewNodes,
+	}
+	for key, want := range tests {
+		if got := keys.action(key); got != want {
+			t.Errorf("action(%q) = %q, want %q", key, got, want)
+		}
+	}
+	if got := keys.label(actionQuit); got != "Ctrl-q" {
+		t.Errorf("label(quit) = %q, want Ctrl-q", got)
+	}
+}
+
+func TestNewLiveKeyMapErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		bindings map[string]any
+		want     string
+	}{
+		{"conflict with a default", map[string]any{"toggle-group": "o"}, "o is bound to both view-nodes and toggle-group"},
+		{"conflict between bindings", map[string]any{"up": "k", "toggle-cloud": []any{"w", "k"}}, "k is bound to both toggle-cloud and up"},
+		{"unknown action", map[string]any{"jump": "J"}, `unknown action "jump"`},
+		{"invalid key", map[string]any{"filter": "ctrl-f"}, `filter: invalid key "ctrl-f"`},
+		{"not a key", map[string]any{"filter": 5}, "filter: expected a key or a list of keys"},
+		{"interrupt rebound", map[string]any{"filter": "<C-c>"}, "<C-c> is bound to both quit and filter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newLiveKeyMap(tt.bindings)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("newLiveKeyMap() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewLiveKeyMapKeepsInterrupt(t *testing.T) {
+	keys, err := newLiveKeyMap(map[string]any{"quit": []any{}})
+	if err != nil {
+		t.Fatalf("newLiveKeyMap() error = %v", err)
+	}
+	if keys.action("<C-c>") != actionQuit || keys.action("q") != "" {
+		t.Error("expected only <C-c> to quit with quit unbound")
+	}
+	if got := keys.label(actionQuit); got != "Ctrl-c" {
+		t.Errorf("label(quit) = %q, want Ctrl-c", got)
+	}
+	if defaults := defaultLiveKeys.keys[actionQuit]; len(defaults) != 2 {
+		t.Errorf("default quit keys changed to %v", defaults)
+	}
+}
+
+func TestInterruptQuitsFromOverlays(t *testing.T) {
+	ctrlC := ui.Event{Type: ui.KeyboardEvent, ID: "<C-c>"}
+	for name, state := range map[string]*LiveState{
+		"filter input":         {filterEditing: true},
+		"settings modal":       {showSettingsModal: true},
+		"discard confirmation": {showSettingsModal: true, showConfirmDiscard: true},
+	} {
+		if !handleUIEvent(ctrlC, nil, nil, state) {
+			t.Errorf("<C-c> did not quit from the %s", name)
+		}
+	}
+
+	var opened []string
+	tabs := newTestLiveTabs(&opened)
+	tabs.showPicker = true
+	if tabs.handleKey("<C-c>") {
+		t.Error("expected <C-c> to reach the view, which quits, with the context picker open")
+	}
+}
+
+func TestMenuTextFollowsKeyMap(t *testing.T) {
+	keys, err := newLiveKeyMap(map[string]any{
+		"view-pods":  "<F3>",
+		"contexts":   []any{},
+		"next-tab":   []any{},
+		"close-tab":  []any{},
+		"up":         "k",
+		"page-up":    "<C-b>",
+		"sort-name":  " ",
+		"toggle-gpu": []any{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := menuText(liveMenu, keys)
+	for _, want := range []string{"[F3]Pods", "[r]Raw [w]Cloud", "[Space]Name", "[k/↓]Select", "[Ctrl-b/PgDn/Home/End]Scroll", "[/]Filter | [?]Settings"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("menu %q does not contain %q", text, want)
+		}
+	}
+	if strings.Contains(text, "Clusters") {
+		t.Errorf("menu %q shows the Clusters group with no keys bound", text)
+	}
+	if got := menuKeyAt(liveMenu, keys, 0, 37); got != "<F3>" {
+		t.Errorf("menuKeyAt() on Pods = %q, want <F3>", got)
+	}
+}
+
+func TestLiveTabsUseKeyMap(t *testing.T) {
+	var opened []string
+	tabs := newTestLiveTabs(&opened)
+	keys, err := newLiveKeyMap(map[string]any{"contexts": "<F2>", "close-tab": "<C-w>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tabs.current().state.keys = keys
+
+	if tabs.handleKey("C") || tabs.showPicker {
+		t.Error("expected C to go to the view once contexts is rebound")
+	}
+	if !tabs.handleKey("<F2>") || !tabs.showPicker {
+		t.Fatal("expected <F2> to open the context picker")
+	}
+	tabs.handleKey("<F2>")
+	if tabs.showPicker {
+		t.Error("expected <F2> to close the context picker")
+	}
+	if tabs.handleKey("X") {
+		t.Error("expected X to go to the view once close-tab is rebound")
+	}
+}
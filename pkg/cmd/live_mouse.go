@@ -0,0 +1,164 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"image"
+	"strings"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+	"github.com/spf13/viper"
+)
+
+// handleMouseEvent applies a mouse event to the live view. Clicks on menu
+// items and wheel scrolling are returned as the key bound to the same
+// action, to be handled like a key press. Clicks on the table are applied
+// directly: clicking a row selects it (clicking the selected row again
+// opens it, as the details key does) and clicking a column header sorts by
+// that column. redraw reports whether the view needs to be redrawn.
+// overlay is true while the context picker is open; it ignores the mouse,
+// and the settings modal only scrolls with the wheel.
+func handleMouseEvent(e ui.Event, state *LiveState, overlay bool) (key string, redraw bool) {
+	if overlay || state.filterEditing || state.showConfirmDiscard {
+		return "", false
+	}
+	keys := state.keyMap()
+	switch e.ID {
+	case "<MouseWheelUp>":
+		if state.showSettingsModal {
+			// The modal pages with <PageUp> whatever the view's bindings are.
+			return "<PageUp>", false
+		}
+		return keys.key(actionPageUp), false
+	case "<MouseWheelDown>":
+		if state.showSettingsModal {
+			return "<PageDown>", false
+		}
+		return keys.key(actionPageDown), false
+	case "<MouseLeft>":
+		if state.showSettingsModal {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+
+	m, ok := e.Payload.(ui.Mouse)
+	if !ok {
+		return "", false
+	}
+	pt := image.Pt(m.X, m.Y)
+
+	if state.menuBar != nil && pt.In(state.menuBar.Inner) {
+		return menuKeyAt(liveMenu, keys, m.Y-state.menuBar.Inner.Min.Y, m.X-state.menuBar.Inner.Min.X), false
+	}
+
+	table := state.table
+	if table == nil || len(table.Rows) == 0 || !pt.In(table.Inner) {
+		return "", false
+	}
+	if m.Y == table.Inner.Min.Y {
+		col := tableColumnAt(table, m.X)
+		if col < 0 || col >= len(table.Rows[0]) {
+			return "", false
+		}
+		mode, ok := sortModeForColumn(col, table.Rows[0][col])
+		if !ok {
+			return "", false
+		}
+		setSortMode(state, mode)
+		return "", true
+	}
+
+	lines := state.rowLines
+	if lines < 1 {
+		lines = 1
+	}
+	row := state.scrollOffsets[state.mode] + (m.Y-table.Inner.Min.Y-1)/lines
+	if row >= state.windowEnd {
+		return "", false
+	}
+	before, _ := state.selection()
+	state.selectDataRow(row)
+	if after, _ := state.selection(); after == before {
+		return keys.key(actionDetails), false
+	}
+	return "", true
+}
+
+// selectDataRow selects the item shown on data row row. In the Pods view a
+// container row selects its pod.
+func (s *LiveState) selectDataRow(row int) {
+	if s.mode != ViewPods {
+		s.setSelection(row)
+		return
+	}
+	for i, r := range s.podRowIndex {
+		if r > row {
+			break
+		}
+		s.setSelection(i)
+	}
+}
+
+// tableColumnAt returns the column of table at screen column x, or -1.
+// Columns are laid out as termui draws them: equal widths unless
+// ColumnWidths is set, each followed by a one-cell separator.
+func tableColumnAt(table *widgets.Table, x int) int {
+	widths := table.ColumnWidths
+	if len(widths) == 0 {
+		n := len(table.Rows[0])
+		if n == 0 {
+			return -1
+		}
+		widths = make([]int, n)
+		for i := range widths {
+			widths[i] = table.Inner.Dx() / n
+		}
+	}
+	start := table.Inner.Min.X
+	for i, w := range widths {
+		if x >= start && x < start+w+1 {
+			return i
+		}
+		start += w + 1
+	}
+	return -1
+}
+
+// sortModeForColumn returns the sort mode for a click on the header of
+// column col: the first (name) column, STATUS, and the CPU and memory
+// columns sort; other columns do not.
+func sortModeForColumn(col int, header string) (SortMode, bool) {
+	switch {
+	case col == 0:
+		return SortByName, true
+	case header == "STATUS":
+		return SortByStatus, true
+	case strings.HasPrefix(header, "CPU"):
+		return SortByCPU, true
+	case strings.HasPrefix(header, "MEMORY"):
+		return SortByMemory, true
+	default:
+		return 0, false
+	}
+}
+
+// setSortMode changes the sort mode and persists it to ~/.glance/config.
+func setSortMode(state *LiveState, mode SortMode) {
+	state.sortMode = mode
+	viper.Set("sort-by", getSortModeString(mode))
+	writeConfigSafe()
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+)
+
+func click(x, y int) ui.Event {
+	return ui.Event{Type: ui.MouseEvent, ID: "<MouseLeft>", Payload: ui.Mouse{X: x, Y: y}}
+}
+
+// mouseTestState returns the Deployments view with a 38-column table of
+// four columns (9 cells wide plus a separator) starting at row 3, and the
+// menu bar below it.
+func mouseTestState() *LiveState {
+	state := &LiveState{mode: ViewDeployments, rowCount: 50, rowLines: 1}
+	state.table = widgets.NewTable()
+	state.table.Rows = [][]string{{"DEPLOYMENT", "STATUS", "CPU REQUESTS/LIMITS", "AGE"}}
+	state.table.SetRect(0, 3, 38, 20)
+	state.scrollWindow(50, 14)
+	state.windowEnd = 14
+	state.menuBar = widgets.NewParagraph()
+	state.menuBar.Border = false
+	state.menuBar.PaddingTop, state.menuBar.PaddingBottom = -1, -1
+	state.menuBar.SetRect(0, 20, 200, 22)
+	return state
+}
+
+func TestMenuText(t *testing.T) {
+	want := " Views: [o]Nodes [n]Namespaces [p]Pods [d]Deployments [P]Pending | " +
+		"Toggle: [b]Bars [%]Percent [r]Raw [u]GPU [w]Cloud [v]Version [a]Age [g]Group\n" +
+		" Sort: [1]Status [2]Name [3]CPU [4]Memory | [↑/↓]Select [PgUp/PgDn/Home/End]Scroll " +
+		"[e]Containers [Enter]Details [Esc]Back [/]Filter | " +
+		"Clusters: [C]Contexts [Tab]Next [X]Close | [?]Settings [q]Quit"
+	if got := menuText(liveMenu, defaultLiveKeys); got != want {
+		t.Errorf("menuText() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestMenuKeyAt(t *testing.T) {
+	tests := []struct {
+		line, x int
+		want    string
+	}{
+		{0, 8, "o"},  // "[" of [o]Nodes
+		{0, 15, "o"}, // "s" of Nodes
+		{0, 16, ""},  // space between items
+		{0, 17, "n"}, // [n]Namespaces
+		{0, 2, ""},   // "Views:" title
+		{1, 8, "1"},  // [1]Status
+		{1, 50, ""},  // [↑/↓]Select documents a key but is not clickable
+		{1, 200, ""}, // past the end of the line
+		{2, 8, ""},   // no such line
+	}
+	for _, tt := range tests {
+		if got := menuKeyAt(liveMenu, defaultLiveKeys, tt.line, tt.x); got != tt.want {
+			t.Errorf("menuKeyAt(%d, %d) = %q, want %q", tt.line, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestHandleMouseEventSelectsRow(t *testing.T) {
+	state := mouseTestState()
+
+	// The header is on line 4, so line 7 shows data row 2.
+	key, redraw := handleMouseEvent(click(5, 7), state, false)
+	if key != "" || !redraw {
+		t.Errorf("click on row = (%q, %v), want redraw", key, redraw)
+	}
+	if sel, _ := state.selection(); sel != 2 {
+		t.Errorf("selection = %d, want 2", sel)
+	}
+
+	// Clicking the selected row again opens it.
+	if key, _ := handleMouseEvent(click(5, 7), state, false); key != "<Enter>" {
+		t.Errorf("second click = %q, want <Enter>", key)
+	}
+
+	// Clicks on the border or while a popup is open are ignored.
+	if key, redraw := handleMouseEvent(click(0, 7), state, false); key != "" || redraw {
+		t.Errorf("click on border = (%q, %v)", key, redraw)
+	}
+	if key, redraw := handleMouseEvent(click(5, 8), state, true); key != "" || redraw {
+		t.Errorf("click under a popup = (%q, %v)", key, redraw)
+	}
+}
+
+func TestHandleMouseEventSelectsPod(t *testing.T) {
+	state := mouseTestState()
+	state.mode = ViewPods
+	state.podKeys = []string{"ns/a", "ns/b", "ns/c"}
+	state.podRowIndex = []int{0, 1, 4}
+	state.windowEnd = 5
+
+	// Data row 3 is a container row of the second pod.
+	handleMouseEvent(click(5, 7), state, false)
+	if state.selectedPodIndex != 1 {
+		t.Errorf("selectedPodIndex = %d, want 1", state.selectedPodIndex)
+	}
+}
+
+func TestHandleMouseEventSortsByHeader(t *testing.T) {
+	tests := []struct {
+		x    int
+		want SortMode
+	}{
+		{3, SortByName},
+		{12, SortByStatus},
+		{22, SortByCPU},
+	}
+	for _, tt := range tests {
+		state := mouseTestState()
+		state.sortMode = SortByMemory
+		if _, redraw := handleMouseEvent(click(tt.x, 4), state, false); !redraw || state.sortMode != tt.want {
+			t.Errorf("header click at %d: sortMode = %v, redraw = %v; want %v", tt.x, state.sortMode, redraw, tt.want)
+		}
+	}
+
+	// AGE does not sort.
+	state := mouseTestState()
+	if _, redraw := handleMouseEvent(click(32, 4), state, false); redraw {
+		t.Error("click on AGE header redrew")
+	}
+}
+
+func TestHandleMouseEventKeys(t *testing.T) {
+	state := mouseTestState()
+	if key, _ := handleMouseEvent(click(18, 20), state, false); key != "n" {
+		t.Errorf("menu click = %q, want n", key)
+	}
+	if key, _ := handleMouseEvent(click(9, 21), state, false); key != "1" {
+		t.Errorf("second menu line click = %q, want 1", key)
+	}
+	wheel := ui.Event{Type: ui.MouseEvent, ID: "<MouseWheelDown>"}
+	if key, _ := handleMouseEvent(wheel, state, false); key != "<PageDown>" {
+		t.Errorf("wheel down = %q, want <PageDown>", key)
+	}
+	if key, _ := handleMouseEvent(wheel, state, true); key != "" {
+		t.Errorf("wheel down over the context picker = %q, want none", key)
+	}
+	if key, _ := handleMouseEvent(ui.Event{Type: ui.MouseEvent, ID: "<MouseRelease>"}, state, false); key != "" {
+		t.Errorf("release = %q, want none", key)
+	}
+	state.filterEditing = true
+	if key, _ := handleMouseEvent(ui.Event{Type: ui.MouseEvent, ID: "<MouseWheelUp>"}, state, false); key != "" {
+		t.Errorf("wheel while editing the filter = %q, want none", key)
+	}
+}
+
+func TestHandleMouseEventRebound(t *testing.T) {
+	state := mouseTestState()
+	keys, err := newLiveKeyMap(map[string]any{"page-down": "J", "view-namespaces": "N"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.keys = keys
+
+	wheel := ui.Event{Type: ui.MouseEvent, ID: "<MouseWheelDown>"}
+	if key, _ := handleMouseEvent(wheel, state, false); key != "J" {
+		t.Errorf("wheel down = %q, want J", key)
+	}
+	if key, _ := handleMouseEvent(click(18, 20), state, false); key != "N" {
+		t.Errorf("menu click = %q, want N", key)
+	}
+
+	// The settings modal keeps its own paging keys.
+	state.showSettingsModal = true
+	if key, _ := handleMouseEvent(wheel, state, false); key != "<PageDown>" {
+		t.Errorf("wheel down in the settings modal = %q, want <PageDown>", key)
+	}
+}
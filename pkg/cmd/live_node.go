@@ -0,0 +1,350 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/jedib0t/go-pretty/v6/text"
+	log "github.com/sirupsen/logrus"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	glanceutil "gitlab.com/davidxarnold/glance/pkg/util"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	metricsV1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// liveNodeSnapshot keeps the nodes and pods of the last Nodes view refresh
+// so that the node detail screen is built from the same data.
+type liveNodeSnapshot struct {
+	nodes map[string]*v1.Node
+	pods  map[string][]v1.Pod // scheduled pods by node name
+	rows  map[string]nodeRowData
+}
+
+// newLiveNodeSnapshot indexes nodes and their processed rows by node name.
+func newLiveNodeSnapshot(nodes []v1.Node, podsByNode map[string][]v1.Pod, rows []nodeRowData) *liveNodeSnapshot {
+	s := &liveNodeSnapshot{
+		nodes: make(map[string]*v1.Node, len(nodes)),
+		pods:  podsByNode,
+		rows:  make(map[string]nodeRowData, len(rows)),
+	}
+	for i := range nodes {
+		s.nodes[nodes[i].Name] = &nodes[i]
+	}
+	for _, r := range rows {
+		s.rows[r.row[0]] = r
+	}
+	return s
+}
+
+// fetchNodeDetailData refreshes the Nodes view data and builds the detail
+// screen of state.detailNode: header lines describing the node and one row
+// per pod scheduled on it. When the node is gone it falls back to the Nodes
+// view.
+func fetchNodeDetailData(
+	ctx context.Context,
+	k8sClient *kubernetes.Clientset,
+	gc *GlanceConfig,
+	state *LiveState,
+) ([]string, [][]string, []ResourceMetrics, error) {
+	header, rows, metrics, err := fetchNodeData(ctx, k8sClient, gc, state)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	node := state.nodeSnapshot.nodes[state.detailNode]
+	if node == nil {
+		log.Debugf("Node %s no longer exists, returning to the Nodes view", state.detailNode)
+		handleEscapeKey(state)
+		return header, rows, metrics, nil
+	}
+	pods := state.nodeSnapshot.pods[node.Name]
+
+	// Node metrics were read by fetchNodeData; pod usage is only needed here.
+	var podMetrics map[string]*metricsV1beta1api.PodMetrics
+	usageKnown := false
+	if state.metricsAvailable && state.metricsSource != nil {
+		podMetrics, err = fetchNodePodMetrics(ctx, state.metricsSource, pods, state.maxConcurrent)
+		if err != nil {
+			log.Debugf("Failed to fetch pod metrics from %s: %v", state.metricsSource.Name(), err)
+		} else {
+			usageKnown = true
+		}
+	}
+
+	width, _ := ui.TerminalDimensions()
+	state.detailHeader = nodeDetailLines(node, state.nodeSnapshot.rows[node.Name], len(pods), width)
+	state.detailFooter = nil
+	header, rows, metrics = buildNodeDetailRows(pods, podMetrics, usageKnown, state)
+	return header, rows, metrics, nil
+}
+
+// fetchNodePodMetrics reads pod usage only for the namespaces of pods, one
+// PodMetrics call per namespace with at most limit calls in flight, so a node
+// detail refresh does not list usage for every pod in the cluster.
+func fetchNodePodMetrics(
+	ctx context.Context,
+	src metricsource.Source,
+	pods []v1.Pod,
+	limit int,
+) (map[string]*metricsV1beta1api.PodMetrics, error) {
+	namespaces := make(map[string]struct{})
+	for i := range pods {
+		namespaces[pods[i].Namespace] = struct{}{}
+	}
+
+	var mu sync.Mutex
+	podMetrics := make(map[string]*metricsV1beta1api.PodMetrics)
+	g, gCtx := errgroup.WithContext(ctx)
+	if limit > 0 {
+		g.SetLimit(limit)
+	}
+	for ns := range namespaces {
+		g.Go(func() error {
+			m, err := src.PodMetrics(gCtx, ns)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			maps.Copy(podMetrics, m)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return podMetrics, nil
+}
+
+// buildNodeDetailRows builds one row per pod with its requests, limits and
+// usage, QoS class and owning workload. The resource columns match the Pods
+// view so progress bars and row colors line up.
+func buildNodeDetailRows(
+	pods []v1.Pod,
+	podMetrics map[string]*metricsV1beta1api.PodMetrics,
+	usageKnown bool,
+	state *LiveState,
+) ([]string, [][]string, []ResourceMetrics) {
+	header := []string{
+		"POD",
+		"CPU REQUESTS/LIMITS",
+		"CPU USAGE/LIMITS",
+		"MEMORY REQUESTS/LIMITS",
+		"MEMORY USAGE/LIMITS",
+		"QOS",
+		"OWNER",
+		"STATUS",
+	}
+
+	aggregates := core.AggregatePods(pods, podMetrics, func(pod *v1.Pod) string {
+		return metricsource.PodKey(pod.Namespace, pod.Name)
+	})
+
+	podData := make([]podRowData, 0, len(pods))
+	for i := range pods {
+		pod := &pods[i]
+		key := metricsource.PodKey(pod.Namespace, pod.Name)
+		agg := aggregates[key]
+		if agg == nil {
+			continue
+		}
+
+		status := string(pod.Status.Phase)
+		if core.IsEvicted(pod) {
+			status = core.ReasonEvicted
+		}
+		qos := string(pod.Status.QOSClass)
+		if qos == "" {
+			qos = "-"
+		}
+		owner := "-"
+		if kind, name := core.WorkloadOf(pod); kind != core.WorkloadPod {
+			owner = kind + "/" + name
+		}
+
+		row := []string{
+			key,
+			formatResourceRatio(&agg.CPURequests, &agg.CPULimits, false, state.showRawResources),
+			formatUsageRatio(&agg.CPUUsage, &agg.CPULimits, false, state.showRawResources, usageKnown),
+			formatResourceRatio(&agg.MemoryRequests, &agg.MemoryLimits, true, state.showRawResources),
+			formatUsageRatio(&agg.MemoryUsage, &agg.MemoryLimits, true, state.showRawResources, usageKnown),
+			qos,
+			owner,
+			getStatusIcon(status) + status,
+		}
+
+		metrics := ResourceMetrics{
+			CPURequest:  cpuCores(&agg.CPURequests),
+			CPULimit:    cpuCores(&agg.CPULimits),
+			CPUUsage:    cpuCores(&agg.CPUUsage),
+			CPUCapacity: cpuCores(&agg.CPULimits),
+			MemRequest:  quantityBytes(&agg.MemoryRequests),
+			MemLimit:    quantityBytes(&agg.MemoryLimits),
+			MemUsage:    quantityBytes(&agg.MemoryUsage),
+			MemCapacity: quantityBytes(&agg.MemoryLimits),
+
+			UsageUnknown: !usageKnown,
+		}
+		if !state.filter.match(liveFilterRow{
+			name: pod.Name, namespace: pod.Namespace, status: status, node: pod.Spec.NodeName,
+			labels: pod.Labels, metrics: &metrics,
+		}) {
+			continue
+		}
+
+		podData = append(podData, podRowData{
+			row:       row,
+			metrics:   metrics,
+			isRunning: pod.Status.Phase == v1.PodRunning,
+			cpuUsage:  safePercentage(metrics.CPUUsage, metrics.CPUCapacity),
+			memUsage:  safePercentage(metrics.MemUsage, metrics.MemCapacity),
+			key:       key,
+		})
+	}
+	sortPodData(podData, state.sortMode)
+
+	rows := make([][]string, 0, len(podData))
+	metrics := make([]ResourceMetrics, 0, len(podData))
+	for _, d := range podData {
+		rows = append(rows, d.row)
+		metrics = append(metrics, d.metrics)
+	}
+	return header, rows, metrics
+}
+
+// nodeDetailLines describes a node for the header of the detail screen:
+// status, conditions, taints, allocatable against capacity, cloud metadata
+// and labels. Taints and labels are cut to fit width.
+func nodeDetailLines(node *v1.Node, row nodeRowData, pods, width int) []string {
+	// Leave room for the border and the label of the line.
+	fit := func(label, s string) string {
+		avail := width - 3 - len(label)
+		if avail > 1 && text.RuneWidthWithoutEscSequences(s) > avail {
+			s = text.Trim(s, avail-1) + "…"
+		}
+		return label + s
+	}
+
+	status := "Unknown"
+	conditions := make([]string, 0, len(node.Status.Conditions))
+	for _, c := range node.Status.Conditions {
+		healthy := c.Status == v1.ConditionFalse
+		if c.Type == v1.NodeReady {
+			healthy = c.Status == v1.ConditionTrue
+			status = statusNotReady + " " + nodeStatusNotReady
+			if healthy {
+				status = statusReady + " " + nodeStatusReady
+			}
+		}
+		color := "green"
+		if !healthy {
+			color = "red"
+		}
+		conditions = append(conditions, fmt.Sprintf("[%s=%s](fg:%s)", c.Type, c.Status, color))
+	}
+	if len(conditions) == 0 {
+		conditions = append(conditions, "<none>")
+	}
+
+	taints := make([]string, 0, len(node.Spec.Taints))
+	for _, t := range node.Spec.Taints {
+		taint := t.Key
+		if t.Value != "" {
+			taint += "=" + t.Value
+		}
+		taints = append(taints, taint+":"+string(t.Effect))
+	}
+	if len(taints) == 0 {
+		taints = append(taints, "<none>")
+	}
+
+	labels := make([]string, 0, len(node.Labels))
+	for k, v := range node.Labels {
+		labels = append(labels, k+"="+v)
+	}
+	sort.Strings(labels)
+	if len(labels) == 0 {
+		labels = append(labels, "<none>")
+	}
+
+	alloc, capacity := node.Status.Allocatable, node.Status.Capacity
+	resources := fmt.Sprintf("CPU %s / %s │ Memory %s / %s │ Pods %s / %s (%d scheduled)",
+		formatMilliCPU(alloc.Cpu()), formatMilliCPU(capacity.Cpu()),
+		formatBytes(alloc.Memory()), formatBytes(capacity.Memory()),
+		alloc.Pods().String(), capacity.Pods().String(), pods)
+	for name, q := range capacity {
+		if core.IsGPUResource(name) {
+			a := alloc[name]
+			resources += fmt.Sprintf(" │ %s %s / %s", name, a.String(), q.String())
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("Status: %s │ Kubelet: %s │ OS: %s/%s │ Age: %s",
+			status, node.Status.NodeInfo.KubeletVersion,
+			node.Status.NodeInfo.OperatingSystem, node.Status.NodeInfo.Architecture,
+			glanceutil.FormatAge(node.CreationTimestamp.Time)),
+		"Conditions: " + strings.Join(conditions, " "),
+		fit("Taints: ", strings.Join(taints, ", ")),
+		"Allocatable / Capacity: " + resources,
+		"Cloud: " + nodeCloudSummary(node, row),
+		fit("Labels: ", strings.Join(labels, ", ")),
+	}
+}
+
+// nodeCloudSummary describes where a node runs, preferring metadata from the
+// cloud provider API over node labels.
+func nodeCloudSummary(node *v1.Node, row nodeRowData) string {
+	provider := ""
+	if node.Spec.ProviderID != "" {
+		cp, _ := glanceutil.ParseProviderID(node.Spec.ProviderID)
+		provider = strings.ToUpper(cp)
+	}
+	instanceType := row.instanceType
+	if instanceType == "" {
+		instanceType = node.Labels[v1.LabelInstanceTypeStable]
+	}
+	nodeGroup := row.nodeGroup
+	if row.fargateProfile != "" {
+		nodeGroup = row.fargateProfile
+	}
+
+	fields := []struct{ label, value string }{
+		{"Provider", provider},
+		{"Region", node.Labels[v1.LabelTopologyRegion]},
+		{"Zone", node.Labels[v1.LabelTopologyZone]},
+		{"Instance type", instanceType},
+		{"Node group", nodeGroup},
+		{"Capacity", row.capacityType},
+	}
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f.value != "" {
+			parts = append(parts, f.label+" "+f.value)
+		}
+	}
+	if len(parts) == 0 {
+		return "<none>"
+	}
+	return strings.Join(parts, " │ ")
+}
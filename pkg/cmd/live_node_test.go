@@ -0,0 +1,206 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNodeDrillDownKeys(t *testing.T) {
+	state := &LiveState{mode: ViewNodes, nodeKeys: []string{"node-a", "node-b"}}
+
+	handleDownArrow(state)
+	handleDownArrow(state)
+	if state.selectedNodeIndex != 1 {
+		t.Fatalf("expected selection to stop at the last node, got %d", state.selectedNodeIndex)
+	}
+	handleEnterKey(state)
+	if state.mode != ViewNodeDetail || state.detailNode != "node-b" {
+		t.Fatalf("expected detail of node-b, got mode %v node %q", state.mode, state.detailNode)
+	}
+
+	// Arrows do not move the node selection behind the detail screen.
+	handleUpArrow(state)
+	if state.selectedNodeIndex != 1 {
+		t.Errorf("selection moved in the detail screen: %d", state.selectedNodeIndex)
+	}
+
+	handleEscapeKey(state)
+	if state.mode != ViewNodes || state.detailNode != "" {
+		t.Errorf("expected Esc to return to the Nodes view, got mode %v node %q", state.mode, state.detailNode)
+	}
+	handleEscapeKey(state)
+	if state.mode != ViewNodes {
+		t.Errorf("Esc in the Nodes view changed the mode to %v", state.mode)
+	}
+}
+
+func TestBuildNodeDetailRows(t *testing.T) {
+	controller := true
+	pods := []v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "payments", Name: "api-7d9f-x1",
+				Labels: map[string]string{"pod-template-hash": "7d9f"},
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "ReplicaSet", Name: "api-7d9f", Controller: &controller},
+				},
+			},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name: "app",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Gi")},
+					Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("2Gi")},
+				},
+			}}},
+			Status: v1.PodStatus{Phase: v1.PodRunning, QOSClass: v1.PodQOSBurstable},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "debug"},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "shell"}}},
+			Status:     v1.PodStatus{Phase: v1.PodPending, QOSClass: v1.PodQOSBestEffort},
+		},
+	}
+	podMetrics := map[string]*metricsv1beta1.PodMetrics{
+		metricsource.PodKey("payments", "api-7d9f-x1"): {Containers: []metricsv1beta1.ContainerMetrics{{
+			Name: "app", Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m"), v1.ResourceMemory: resource.MustParse("512Mi")},
+		}}},
+	}
+
+	state := &LiveState{sortMode: SortByName}
+	header, rows, metrics := buildNodeDetailRows(pods, podMetrics, true, state)
+	if len(rows) != 2 || len(metrics) != 2 || len(rows[0]) != len(header) {
+		t.Fatalf("expected 2 rows matching the header, got %v", rows)
+	}
+	if rows[0][0] != "default/debug" || rows[1][0] != "payments/api-7d9f-x1" {
+		t.Errorf("expected rows sorted by namespace/name, got %q, %q", rows[0][0], rows[1][0])
+	}
+	if got := rows[1][5:7]; got[0] != "Burstable" || got[1] != "Deployment/api" {
+		t.Errorf("unexpected QoS and owner %v", got)
+	}
+	if rows[0][6] != "-" {
+		t.Errorf("expected no owner for a bare pod, got %q", rows[0][6])
+	}
+	if metrics[1].CPUUsage != 0.25 || metrics[1].CPUCapacity != 1 || metrics[1].UsageUnknown {
+		t.Errorf("unexpected metrics %+v", metrics[1])
+	}
+
+	_, rows, metrics = buildNodeDetailRows(pods, nil, false, state)
+	if rows[1][2] != usageNotAvailable || !metrics[1].UsageUnknown {
+		t.Errorf("expected usage n/a without metrics, got %q", rows[1][2])
+	}
+}
+
+func TestNodeDetailLines(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+			Labels: map[string]string{
+				v1.LabelTopologyRegion:        "us-east-1",
+				v1.LabelTopologyZone:          "us-east-1a",
+				v1.LabelInstanceTypeStable:    "m5.large",
+				"team":                        strings.Repeat("x", 200),
+				"eks.amazonaws.com/nodegroup": "general",
+			},
+		},
+		Spec: v1.NodeSpec{
+			ProviderID: "aws:///us-east-1a/i-0123",
+			Taints:     []v1.Taint{{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule}},
+		},
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: v1.ConditionTrue},
+				{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue},
+			},
+			Capacity: v1.ResourceList{
+				v1.ResourceCPU: resource.MustParse("2"), v1.ResourceMemory: resource.MustParse("8Gi"), v1.ResourcePods: resource.MustParse("29"),
+			},
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU: resource.MustParse("1930m"), v1.ResourceMemory: resource.MustParse("7Gi"), v1.ResourcePods: resource.MustParse("29"),
+			},
+		},
+	}
+	row := nodeRowData{nodeGroup: "general", capacityType: "SPOT"}
+
+	lines := nodeDetailLines(node, row, 3, 100)
+	text := strings.Join(lines, "\n")
+	for _, want := range []string{
+		statusReady + " " + nodeStatusReady,
+		"[Ready=True](fg:green)",
+		"[MemoryPressure=True](fg:red)",
+		"Taints: dedicated=gpu:NoSchedule",
+		"CPU 1.9 / 2.0 │ Memory 7.00Gi / 8.00Gi │ Pods 29 / 29 (3 scheduled)",
+		"Provider AWS │ Region us-east-1 │ Zone us-east-1a │ Instance type m5.large │ Node group general │ Capacity SPOT",
+		"Labels: eks.amazonaws.com/nodegroup=general",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+	labels := lines[len(lines)-1]
+	if !strings.HasSuffix(labels, "…") || len([]rune(labels)) > 100 {
+		t.Errorf("expected labels cut to the width, got %d runes", len([]rune(labels)))
+	}
+
+	node.Spec = v1.NodeSpec{}
+	node.Labels = nil
+	lines = nodeDetailLines(node, nodeRowData{}, 0, 100)
+	if lines[2] != "Taints: <none>" || lines[4] != "Cloud: <none>" || lines[5] != "Labels: <none>" {
+		t.Errorf("expected placeholders for a bare node, got %q", lines)
+	}
+}
+
+func TestFetchNodePodMetrics(t *testing.T) {
+	podMetrics := func(namespace, name string) *metricsv1beta1.PodMetrics {
+		return &metricsv1beta1.PodMetrics{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	source := &stubMetricsSource{pods: map[string]*metricsv1beta1.PodMetrics{
+		metricsource.PodKey("shop", "web"):   podMetrics("shop", "web"),
+		metricsource.PodKey("shop", "db"):    podMetrics("shop", "db"),
+		metricsource.PodKey("infra", "dns"):  podMetrics("infra", "dns"),
+		metricsource.PodKey("other", "busy"): podMetrics("other", "busy"),
+	}}
+	pods := []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "db"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "dns"}},
+	}
+
+	got, err := fetchNodePodMetrics(context.Background(), source, pods, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sort.Strings(source.namespaces)
+	if strings.Join(source.namespaces, ",") != "infra,shop" {
+		t.Errorf("expected one call per pod namespace, got %q", source.namespaces)
+	}
+	if len(got) != 3 || got[metricsource.PodKey("other", "busy")] != nil {
+		t.Errorf("expected usage of the node's three pods, got %d entries", len(got))
+	}
+
+	source = &stubMetricsSource{err: errors.New("metrics unavailable")}
+	if _, err := fetchNodePodMetrics(context.Background(), source, pods, 0); err == nil {
+		t.Error("expected the source error to be returned")
+	}
+}
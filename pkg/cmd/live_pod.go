@@ -0,0 +1,308 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/jedib0t/go-pretty/v6/text"
+	log "github.com/sirupsen/logrus"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	glanceutil "gitlab.com/davidxarnold/glance/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	metricsV1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// podDetailEventLimit is the number of recent events shown for a pod.
+const podDetailEventLimit = 8
+
+// fetchPodDetailData builds the detail screen of state.detailPod: header
+// lines describing the pod, one row per container and its most recent
+// events. When the pod is gone it falls back to the Pods view.
+func fetchPodDetailData(
+	ctx context.Context,
+	k8sClient *kubernetes.Clientset,
+	gc *GlanceConfig,
+	state *LiveState,
+) ([]string, [][]string, []ResourceMetrics, error) {
+	namespace, name, _ := strings.Cut(state.detailPod, "/")
+	pod, err := state.getPod(ctx, k8sClient, namespace, name)
+	if k8serrors.IsNotFound(err) {
+		log.Debugf("Pod %s no longer exists, returning to the Pods view", state.detailPod)
+		handleEscapeKey(state)
+		return fetchPodData(ctx, k8sClient, gc, state.selectedNamespace, state)
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get pod %s: %w", state.detailPod, err)
+	}
+
+	var pm *metricsV1beta1api.PodMetrics
+	var metricsErr error
+	if state.metricsSource != nil {
+		var podMetrics map[string]*metricsV1beta1api.PodMetrics
+		podMetrics, metricsErr = state.metricsSource.PodMetrics(ctx, namespace)
+		pm = podMetrics[metricsource.PodKey(namespace, name)]
+	}
+	if err := state.recordMetricsResult(metricsErr); err != nil {
+		return nil, nil, nil, err
+	}
+
+	width, _ := ui.TerminalDimensions()
+	state.detailHeader = podDetailLines(pod)
+	state.detailFooter = podEventLines(recentPodEvents(ctx, k8sClient, pod, podDetailEventLimit), width)
+	header, rows, metrics := buildPodDetailRows(pod, pm, state)
+	return header, rows, metrics, nil
+}
+
+// buildPodDetailRows builds one row per container with its image, requests,
+// limits and usage, state and restart history.
+func buildPodDetailRows(
+	pod *v1.Pod,
+	pm *metricsV1beta1api.PodMetrics,
+	state *LiveState,
+) ([]string, [][]string, []ResourceMetrics) {
+	header := []string{
+		"CONTAINER",
+		"IMAGE",
+		"CPU REQUESTS/LIMITS",
+		"CPU USAGE/LIMITS",
+		"MEMORY REQUESTS/LIMITS",
+		"MEMORY USAGE/LIMITS",
+		"STATE",
+		"RESTARTS",
+		"LAST TERMINATION",
+	}
+
+	images := make(map[string]string, len(pod.Spec.Containers))
+	for _, c := range pod.Spec.Containers {
+		images[c.Name] = c.Image
+	}
+	statuses := make(map[string]*v1.ContainerStatus, len(pod.Status.ContainerStatuses))
+	for i := range pod.Status.ContainerStatuses {
+		statuses[pod.Status.ContainerStatuses[i].Name] = &pod.Status.ContainerStatuses[i]
+	}
+
+	containers := collectContainerStats(pod, pm)
+	rows := make([][]string, 0, len(containers))
+	metrics := make([]ResourceMetrics, 0, len(containers))
+	for _, c := range containers {
+		cs := statuses[c.Name]
+		// Bars and row colors use the same figures as the container rows
+		// of the Pods view.
+		_, m := buildContainerLiveRow(c, state)
+		if !state.filter.match(liveFilterRow{
+			name: c.Name, namespace: pod.Namespace, status: containerStateName(cs), node: pod.Spec.NodeName, metrics: &m,
+		}) {
+			continue
+		}
+		rows = append(rows, []string{
+			c.Name,
+			images[c.Name],
+			formatResourceRatio(c.CPUReq, c.CPULimit, false, state.showRawResources),
+			formatUsageRatio(c.CPUUsage, c.CPULimit, false, state.showRawResources, state.metricsAvailable),
+			formatResourceRatio(c.MemReq, c.MemLimit, true, state.showRawResources),
+			formatUsageRatio(c.MemUsage, c.MemLimit, true, state.showRawResources, state.metricsAvailable),
+			containerStateString(cs),
+			fmt.Sprintf("%d", c.RestartCount),
+			containerTerminationString(cs),
+		})
+		metrics = append(metrics, m)
+	}
+	return header, rows, metrics
+}
+
+// containerStateString describes the current state of a container, e.g.
+// "Running 3d", "CrashLoopBackOff" or "Completed (exit 0)".
+func containerStateString(cs *v1.ContainerStatus) string {
+	if cs == nil {
+		return "-"
+	}
+	switch {
+	case cs.State.Running != nil:
+		s := getStatusIcon(string(v1.PodRunning)) + "Running " + glanceutil.FormatAge(cs.State.Running.StartedAt.Time)
+		if !cs.Ready {
+			s += ", not ready"
+		}
+		return s
+	case cs.State.Waiting != nil:
+		return statusPending + " " + cs.State.Waiting.Reason
+	case cs.State.Terminated != nil:
+		return fmt.Sprintf("%s %s (exit %d)", statusFailed, cs.State.Terminated.Reason, cs.State.Terminated.ExitCode)
+	default:
+		return "-"
+	}
+}
+
+// containerStateName is the state of a container as matched by the live
+// filter: Running, or the reason it is waiting or terminated.
+func containerStateName(cs *v1.ContainerStatus) string {
+	switch {
+	case cs == nil:
+		return ""
+	case cs.State.Running != nil:
+		return string(v1.PodRunning)
+	case cs.State.Waiting != nil:
+		return cs.State.Waiting.Reason
+	case cs.State.Terminated != nil:
+		return cs.State.Terminated.Reason
+	default:
+		return ""
+	}
+}
+
+// containerTerminationString describes the last termination of a container
+// with its exit code, e.g. "OOMKilled, exit 137 (5m ago)".
+func containerTerminationString(cs *v1.ContainerStatus) string {
+	if cs == nil {
+		return formatTermination("", time.Time{})
+	}
+	term := core.LastTermination(cs)
+	if term == nil {
+		return formatTermination("", time.Time{})
+	}
+	return formatTermination(fmt.Sprintf("%s, exit %d", term.Reason, term.ExitCode), term.FinishedAt.Time)
+}
+
+// podDetailLines describes a pod for the header of the detail screen:
+// status and placement, conditions and restart history.
+func podDetailLines(pod *v1.Pod) []string {
+	status := string(pod.Status.Phase)
+	if core.IsEvicted(pod) {
+		status = core.ReasonEvicted
+	}
+	node := pod.Spec.NodeName
+	if node == "" {
+		node = "<unscheduled>"
+	} else if pod.Status.HostIP != "" {
+		node += " (" + pod.Status.HostIP + ")"
+	}
+	podIP := pod.Status.PodIP
+	if podIP == "" {
+		podIP = "-"
+	}
+	qos := string(pod.Status.QOSClass)
+	if qos == "" {
+		qos = "-"
+	}
+	kind, owner := core.WorkloadOf(pod)
+	if kind == core.WorkloadPod {
+		owner = "-"
+	} else {
+		owner = kind + "/" + owner
+	}
+
+	conditions := make([]string, 0, len(pod.Status.Conditions))
+	for _, c := range pod.Status.Conditions {
+		color := "green"
+		if c.Status != v1.ConditionTrue {
+			color = "red"
+		}
+		conditions = append(conditions, fmt.Sprintf("[%s=%s](fg:%s)", c.Type, c.Status, color))
+	}
+	if len(conditions) == 0 {
+		conditions = append(conditions, "<none>")
+	}
+
+	var restarts int32
+	last := "-"
+	var lastAt metav1.Time
+	for i := range pod.Status.ContainerStatuses {
+		cs := &pod.Status.ContainerStatuses[i]
+		restarts += cs.RestartCount
+		if term := core.LastTermination(cs); term != nil && !term.FinishedAt.Before(&lastAt) {
+			lastAt = term.FinishedAt
+			last = formatTermination(fmt.Sprintf("%s in %s, exit %d", term.Reason, cs.Name, term.ExitCode), term.FinishedAt.Time)
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("Status: %s%s │ Node: %s │ Pod IP: %s │ QoS: %s │ Owner: %s │ Age: %s",
+			getStatusIcon(status), status, node, podIP, qos, owner,
+			glanceutil.FormatAge(pod.CreationTimestamp.Time)),
+		"Conditions: " + strings.Join(conditions, " "),
+		fmt.Sprintf("Restarts: %d │ Last termination: %s", restarts, last),
+	}
+}
+
+// recentPodEvents returns up to limit events of pod, most recent first.
+// Failures are logged and yield no events.
+func recentPodEvents(ctx context.Context, k8sClient kubernetes.Interface, pod *v1.Pod, limit int) []v1.Event {
+	selector := fields.Set{
+		"involvedObject.kind": "Pod",
+		"involvedObject.name": pod.Name,
+	}.AsSelector().String()
+	events, err := k8sClient.CoreV1().Events(pod.Namespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		log.Debugf("Failed to list events for pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		return nil
+	}
+
+	result := make([]v1.Event, 0, len(events.Items))
+	for _, ev := range events.Items {
+		if ev.InvolvedObject.Kind != "Pod" || ev.InvolvedObject.Name != pod.Name {
+			continue
+		}
+		// Skip events of an earlier pod with the same name.
+		if ev.InvolvedObject.UID != "" && pod.UID != "" && ev.InvolvedObject.UID != pod.UID {
+			continue
+		}
+		result = append(result, ev)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return eventTimestamp(&result[i]).After(eventTimestamp(&result[j]))
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+// podEventLines formats events as "age type reason message (xN)", cutting
+// messages to fit width. Warnings are shown in yellow.
+func podEventLines(events []v1.Event, width int) []string {
+	if len(events) == 0 {
+		return []string{"<none>"}
+	}
+	lines := make([]string, 0, len(events))
+	for i := range events {
+		ev := &events[i]
+		line := fmt.Sprintf("%-8s %-7s %-18s %s",
+			glanceutil.FormatAge(eventTimestamp(ev))+" ago", ev.Type, ev.Reason,
+			strings.Join(strings.Fields(ev.Message), " "))
+		if ev.Count > 1 {
+			line += fmt.Sprintf(" (x%d)", ev.Count)
+		}
+		// Leave room for the border.
+		if avail := width - 3; avail > 1 && text.RuneWidthWithoutEscSequences(line) > avail {
+			line = text.Trim(line, avail-1) + "…"
+		}
+		// termui would read brackets in messages as style markup.
+		line = strings.NewReplacer("[", "(", "]", ")").Replace(line)
+		if ev.Type == v1.EventTypeWarning {
+			line = "[" + line + "](fg:yellow)"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
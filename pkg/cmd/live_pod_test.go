@@ -0,0 +1,190 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func testDetailPod() *v1.Pod {
+	controller := true
+	finished := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "payments", Name: "worker-0", UID: "uid-2",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+			OwnerReferences:   []metav1.OwnerReference{{Kind: "StatefulSet", Name: "worker", Controller: &controller}},
+		},
+		Spec: v1.PodSpec{
+			NodeName: "node-1",
+			Containers: []v1.Container{
+				{
+					Name: "app", Image: "registry.example.com/worker:1.4",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("256Mi")},
+						Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("512Mi")},
+					},
+				},
+				{Name: "proxy", Image: "envoyproxy/envoy:v1.30"},
+			},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning, HostIP: "10.0.0.7", PodIP: "10.1.2.3", QOSClass: v1.PodQOSBurstable,
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodScheduled, Status: v1.ConditionTrue},
+				{Type: v1.PodReady, Status: v1.ConditionFalse},
+			},
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name: "app", RestartCount: 3,
+					State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+						Reason: "OOMKilled", ExitCode: 137, FinishedAt: finished,
+					}},
+				},
+				{
+					Name: "proxy", Ready: true,
+					State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(time.Now().Add(-time.Hour))}},
+				},
+			},
+		},
+	}
+}
+
+func TestPodDrillDownKeys(t *testing.T) {
+	state := &LiveState{mode: ViewNamespaces, namespaceList: []string{"payments"}}
+
+	// Enter on a namespace opens its pods, not the detail of a pod.
+	handleEnterKey(state)
+	if state.mode != ViewPods || state.detailPod != "" {
+		t.Fatalf("expected the Pods view, got mode %v pod %q", state.mode, state.detailPod)
+	}
+
+	state.podKeys = []string{"payments/api-1", "payments/worker-0"}
+	handleDownArrow(state)
+	handleEnterKey(state)
+	if state.mode != ViewPodDetail || state.detailPod != "payments/worker-0" {
+		t.Fatalf("expected detail of payments/worker-0, got mode %v pod %q", state.mode, state.detailPod)
+	}
+
+	handleEscapeKey(state)
+	if state.mode != ViewPods || state.detailPod != "" {
+		t.Errorf("expected Esc to return to the Pods view, got mode %v pod %q", state.mode, state.detailPod)
+	}
+}
+
+func TestBuildPodDetailRows(t *testing.T) {
+	pod := testDetailPod()
+	pm := &metricsv1beta1.PodMetrics{Containers: []metricsv1beta1.ContainerMetrics{{
+		Name: "app", Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m"), v1.ResourceMemory: resource.MustParse("300Mi")},
+	}}}
+
+	state := &LiveState{metricsAvailable: true, showGPU: true}
+	header, rows, metrics := buildPodDetailRows(pod, pm, state)
+	if len(rows) != 2 || len(metrics) != 2 {
+		t.Fatalf("expected one row per container, got %v", rows)
+	}
+	for _, row := range rows {
+		if len(row) != len(header) {
+			t.Fatalf("row %v does not match header %v", row, header)
+		}
+	}
+	app, proxy := rows[0], rows[1]
+	if app[1] != "registry.example.com/worker:1.4" || app[6] != statusPending+" CrashLoopBackOff" || app[7] != "3" {
+		t.Errorf("unexpected app row %v", app)
+	}
+	if app[8] != "OOMKilled, exit 137 (5m ago)" {
+		t.Errorf("unexpected last termination %q", app[8])
+	}
+	if !strings.HasPrefix(proxy[6], statusRunning+" Running") || strings.Contains(proxy[6], "not ready") || proxy[8] != "—" {
+		t.Errorf("unexpected proxy row %v", proxy)
+	}
+	if metrics[0].CPUUsage != 0.25 || metrics[0].CPUCapacity != 1 || metrics[0].UsageUnknown {
+		t.Errorf("unexpected app metrics %+v", metrics[0])
+	}
+}
+
+func TestPodDetailLines(t *testing.T) {
+	lines := podDetailLines(testDetailPod())
+	text := strings.Join(lines, "\n")
+	for _, want := range []string{
+		"Status: " + statusRunning + " Running",
+		"Node: node-1 (10.0.0.7)",
+		"Pod IP: 10.1.2.3",
+		"QoS: Burstable",
+		"Owner: StatefulSet/worker",
+		"[PodScheduled=True](fg:green) [Ready=False](fg:red)",
+		"Restarts: 3 │ Last termination: OOMKilled in app, exit 137 (5m ago)",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+
+	pending := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "debug"}, Status: v1.PodStatus{Phase: v1.PodPending}}
+	lines = podDetailLines(pending)
+	if !strings.Contains(lines[0], "Node: <unscheduled>") || !strings.Contains(lines[0], "Owner: -") ||
+		lines[1] != "Conditions: <none>" || lines[2] != "Restarts: 0 │ Last termination: -" {
+		t.Errorf("unexpected lines for a pending pod: %q", lines)
+	}
+}
+
+func TestRecentPodEvents(t *testing.T) {
+	pod := testDetailPod()
+	event := func(name, uid, reason string, ago time.Duration) *v1.Event {
+		return &v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "payments", Name: name + "." + reason},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "payments", Name: name, UID: types.UID(uid)},
+			Reason:         reason,
+			Type:           v1.EventTypeWarning,
+			Message:        "Back-off restarting failed container app in pod [worker-0]",
+			Count:          12,
+			LastTimestamp:  metav1.NewTime(time.Now().Add(-ago)),
+		}
+	}
+	client := fake.NewSimpleClientset(
+		event("worker-0", "uid-2", "BackOff", 3*time.Minute),
+		event("worker-0", "uid-2", "Pulled", 10*time.Minute),
+		event("worker-0", "uid-2", "Started", 9*time.Minute),
+		event("worker-0", "uid-1", "Killing", 30*time.Second), // earlier pod with the same name
+		event("api-1", "uid-3", "Unhealthy", time.Second),
+	)
+
+	events := recentPodEvents(context.Background(), client, pod, 2)
+	if len(events) != 2 || events[0].Reason != "BackOff" || events[1].Reason != "Started" {
+		t.Fatalf("expected the two most recent events of the pod, got %+v", events)
+	}
+
+	lines := podEventLines(events, 200)
+	if !strings.HasPrefix(lines[0], "[3m ago") || !strings.HasSuffix(lines[0], "(worker-0) (x12)](fg:yellow)") {
+		t.Errorf("expected a yellow warning with escaped brackets, got %q", lines[0])
+	}
+	lines = podEventLines(events, 60)
+	if !strings.HasSuffix(lines[0], "…](fg:yellow)") {
+		t.Errorf("expected the message cut to the width, got %q", lines[0])
+	}
+	if got := podEventLines(nil, 60); len(got) != 1 || got[0] != "<none>" {
+		t.Errorf("expected a placeholder without events, got %q", got)
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import "fmt"
+
+// Selection and scrolling of the live table. Positions count data rows,
+// not table rows: the header and progress bar rows are added when the
+// visible window is rendered, so scrolling never hides the header.
+
+// selection returns the selected item of the current view and the number
+// of items to select from. Namespaces, Pods and Nodes keep their own index
+// since Enter acts on it; the other views select data rows.
+func (s *LiveState) selection() (index, count int) {
+	switch s.mode {
+	case ViewNamespaces:
+		return s.selectedNamespaceIndex, len(s.namespaceList)
+	case ViewPods:
+		return s.selectedPodIndex, len(s.podKeys)
+	case ViewNodes:
+		return s.selectedNodeIndex, len(s.nodeKeys)
+	default:
+		return s.selectedRows[s.mode], s.rowCount
+	}
+}
+
+// setSelection selects item index of the current view, clamped to the
+// items available.
+func (s *LiveState) setSelection(index int) {
+	_, count := s.selection()
+	if index >= count {
+		index = count - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	switch s.mode {
+	case ViewNamespaces:
+		s.selectedNamespaceIndex = index
+	case ViewPods:
+		s.selectedPodIndex = index
+	case ViewNodes:
+		s.selectedNodeIndex = index
+	default:
+		if s.selectedRows == nil {
+			s.selectedRows = make(map[ViewMode]int)
+		}
+		s.selectedRows[s.mode] = index
+	}
+}
+
+// moveSelection moves the selection of the current view by delta items.
+func (s *LiveState) moveSelection(delta int) {
+	index, _ := s.selection()
+	s.setSelection(index + delta)
+}
+
+// page returns the number of items moved by PgUp and PgDn: the data rows
+// visible in the last render.
+func (s *LiveState) page() int {
+	if s.pageSize < 1 {
+		return 1
+	}
+	return s.pageSize
+}
+
+// selectedDataRow returns the data row of the selected item, or -1 when the
+// view has nothing to select.
+func (s *LiveState) selectedDataRow() int {
+	index, count := s.selection()
+	if index >= count {
+		return -1
+	}
+	if s.mode == ViewPods {
+		// Container rows are interleaved with the pod rows.
+		if index >= len(s.podRowIndex) {
+			return -1
+		}
+		return s.podRowIndex[index]
+	}
+	return index
+}
+
+// scrollWindow returns the range [first, end) of total data rows shown in
+// a table with room for capacity data rows. The window keeps its position
+// between refreshes and moves only as far as needed to show the selection.
+func (s *LiveState) scrollWindow(total, capacity int) (first, end int) {
+	if capacity < 1 {
+		capacity = 1
+	}
+	first = s.scrollOffsets[s.mode]
+	if sel := s.selectedDataRow(); sel >= 0 {
+		if sel < first {
+			first = sel
+		}
+		if sel >= first+capacity {
+			first = sel - capacity + 1
+		}
+	}
+	if first > total-capacity {
+		first = total - capacity
+	}
+	if first < 0 {
+		first = 0
+	}
+	if s.scrollOffsets == nil {
+		s.scrollOffsets = make(map[ViewMode]int)
+	}
+	s.scrollOffsets[s.mode] = first
+	s.pageSize = capacity
+	return first, min(first+capacity, total)
+}
+
+// rowsIndicator describes the visible window for the status bar, e.g.
+// "Rows 41–80 of 812".
+func rowsIndicator(first, end, total int) string {
+	if total == 0 {
+		return "Rows 0 of 0"
+	}
+	return fmt.Sprintf("Rows %d–%d of %d", first+1, end, total)
+}
+
+// shownCount returns how many of total items a limit lets through; a limit
+// of 0 shows all of them.
+func shownCount(limit, total int) int {
+	if limit <= 0 {
+		return total
+	}
+	return min(limit, total)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestScrollWindowFollowsSelection(t *testing.T) {
+	keys := make([]string, 800)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("node-%03d", i)
+	}
+	state := &LiveState{mode: ViewNodes, nodeKeys: keys}
+
+	first, end := state.scrollWindow(len(keys), 40)
+	if first != 0 || end != 40 {
+		t.Fatalf("initial window = [%d, %d), want [0, 40)", first, end)
+	}
+
+	// Moving within the window does not scroll.
+	state.setSelection(39)
+	if first, _ = state.scrollWindow(len(keys), 40); first != 0 {
+		t.Errorf("window scrolled to %d with the selection still visible", first)
+	}
+
+	// One row past the bottom scrolls by one row.
+	handleDownArrow(state)
+	if first, end = state.scrollWindow(len(keys), 40); first != 1 || end != 41 {
+		t.Errorf("window = [%d, %d), want [1, 41)", first, end)
+	}
+
+	// PgDn moves a page; End and Home jump to the last and first rows.
+	state.moveSelection(state.page())
+	if state.selectedNodeIndex != 80 {
+		t.Errorf("PgDn selected %d, want 80", state.selectedNodeIndex)
+	}
+	state.setSelection(len(keys) - 1)
+	if first, end = state.scrollWindow(len(keys), 40); first != 760 || end != 800 {
+		t.Errorf("End window = [%d, %d), want [760, 800)", first, end)
+	}
+	state.setSelection(0)
+	if first, _ = state.scrollWindow(len(keys), 40); first != 0 {
+		t.Errorf("Home window starts at %d, want 0", first)
+	}
+}
+
+func TestScrollWindowShrinkingRows(t *testing.T) {
+	state := &LiveState{mode: ViewDeployments, rowCount: 100}
+	state.setSelection(99)
+	state.scrollWindow(100, 20)
+
+	// The filter leaves five rows: the selection and window move back.
+	state.rowCount = 5
+	state.moveSelection(0)
+	first, end := state.scrollWindow(5, 20)
+	if first != 0 || end != 5 {
+		t.Errorf("window = [%d, %d), want [0, 5)", first, end)
+	}
+	if sel, _ := state.selection(); sel != 4 {
+		t.Errorf("selection = %d, want 4", sel)
+	}
+}
+
+func TestSelectionPerView(t *testing.T) {
+	state := &LiveState{mode: ViewPending, rowCount: 10}
+	state.moveSelection(3)
+	state.mode = ViewDeployments
+	if sel, _ := state.selection(); sel != 0 {
+		t.Errorf("Deployments selection = %d, want 0", sel)
+	}
+	state.mode = ViewPending
+	if sel, _ := state.selection(); sel != 3 {
+		t.Errorf("Pending selection = %d, want 3", sel)
+	}
+}
+
+func TestSelectedDataRowPods(t *testing.T) {
+	// The second pod is expanded, so the third pod is on data row 4.
+	state := &LiveState{
+		mode:        ViewPods,
+		podKeys:     []string{"ns/a", "ns/b", "ns/c"},
+		podRowIndex: []int{0, 1, 4},
+	}
+	state.setSelection(2)
+	if got := state.selectedDataRow(); got != 4 {
+		t.Errorf("selectedDataRow() = %d, want 4", got)
+	}
+}
+
+func TestRowsIndicator(t *testing.T) {
+	if got := rowsIndicator(40, 80, 812); got != "Rows 41–80 of 812" {
+		t.Errorf("rowsIndicator() = %q", got)
+	}
+	if got := rowsIndicator(0, 0, 0); got != "Rows 0 of 0" {
+		t.Errorf("rowsIndicator() = %q", got)
+	}
+}
+
+func TestShownCount(t *testing.T) {
+	tests := []struct{ limit, total, want int }{
+		{0, 800, 800},
+		{20, 800, 20},
+		{100, 30, 30},
+	}
+	for _, tt := range tests {
+		if got := shownCount(tt.limit, tt.total); got != tt.want {
+			t.Errorf("shownCount(%d, %d) = %d, want %d", tt.limit, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestAdjustModalLimitUnlimited(t *testing.T) {
+	state := &LiveState{pendingNodeLimit: 10}
+	adjustModalLimit(state, []string{"", "Node Limit (←/→ adjust)", "10"}, -10)
+	adjustModalLimit(state, []string{"", "Node Limit (←/→ adjust)", "0"}, -10)
+	if state.pendingNodeLimit != 0 {
+		t.Errorf("pendingNodeLimit = %d, want 0", state.pendingNodeLimit)
+	}
+	if got := limitValue(state.pendingNodeLimit); got != "unlimited" {
+		t.Errorf("limitValue(0) = %q, want unlimited", got)
+	}
+}
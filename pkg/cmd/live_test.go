@@ -76,6 +76,7 @@ func TestGetModeString(t *testing.T) {
 		{ViewPods, "PODS"},
 		{ViewNodes, "NODES"},
 		{ViewDeployments, "DEPLOYMENTS"},
+		{ViewNodeDetail, "NODE DETAIL"},
 		{ViewMode(999), "UNKNOWN"},
 	}
 
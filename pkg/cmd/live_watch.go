@@ -0,0 +1,185 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// activePodFieldSelector selects pods that still hold node resources.
+const activePodFieldSelector = "status.phase!=Succeeded,status.phase!=Failed"
+
+// liveWatchSyncTimeout bounds the wait for the initial informer sync, so a
+// cluster whose nodes, pods or namespaces cannot be listed fails instead of
+// hanging the live view.
+const liveWatchSyncTimeout = 30 * time.Second
+
+// startLiveWatch starts an informer cache for live --watch and waits up to
+// liveWatchSyncTimeout for its initial sync. Resync is disabled: the view is
+// refreshed on changes and on the refresh tick, so periodic resyncs would
+// only add redraws.
+func startLiveWatch(k8sClient kubernetes.Interface) (*WatchCache, error) {
+	return startLiveWatchTimeout(k8sClient, liveWatchSyncTimeout)
+}
+
+func startLiveWatchTimeout(k8sClient kubernetes.Interface, timeout time.Duration) (*WatchCache, error) {
+	log.Debug("Syncing node, pod and namespace informers...")
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	wc := NewWatchCache(k8sClient, 0)
+	if err := wc.Start(ctx); err != nil {
+		wc.Stop()
+		return nil, fmt.Errorf("failed to start informers: %w", err)
+	}
+	return wc, nil
+}
+
+// cacheUpdates returns the change notifications of the informer cache, or
+// nil (which never fires) when the view lists from the API server.
+func (s *LiveState) cacheUpdates() <-chan struct{} {
+	if s.cache == nil {
+		return nil
+	}
+	return s.cache.Updates()
+}
+
+// listNodes returns all nodes, from the informer cache under --watch and
+// from the API server otherwise.
+func (s *LiveState) listNodes(ctx context.Context, k8sClient kubernetes.Interface) ([]v1.Node, error) {
+	if s.cache != nil {
+		return s.cache.GetNodes(), nil
+	}
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		ResourceVersion: "0", // Use watch cache for faster response
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nodes.Items, nil
+}
+
+// listPods returns the pods of namespace (all namespaces when empty) that
+// match fieldSelector. Under --watch the selector is applied to the informer
+// cache locally; only the pod fields in podFieldSet are supported.
+func (s *LiveState) listPods(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	namespace, fieldSelector string,
+) ([]v1.Pod, error) {
+	if s.cache == nil {
+		pods, err := k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			ResourceVersion: "0",
+			FieldSelector:   fieldSelector,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return pods.Items, nil
+	}
+
+	sel := fields.Everything()
+	if fieldSelector != "" {
+		var err error
+		if sel, err = fields.ParseSelector(fieldSelector); err != nil {
+			return nil, err
+		}
+	}
+	all := s.cache.GetPods()
+	pods := make([]v1.Pod, 0, len(all))
+	for i := range all {
+		if namespace != "" && all[i].Namespace != namespace {
+			continue
+		}
+		if sel.Matches(podFieldSet(&all[i])) {
+			pods = append(pods, all[i])
+		}
+	}
+	return pods, nil
+}
+
+// podFieldSet returns the pod fields supported by listPods field selectors.
+func podFieldSet(pod *v1.Pod) fields.Set {
+	return fields.Set{
+		"metadata.name":      pod.Name,
+		"metadata.namespace": pod.Namespace,
+		"spec.nodeName":      pod.Spec.NodeName,
+		"status.phase":       string(pod.Status.Phase),
+	}
+}
+
+// getPod returns one pod, from the informer cache under --watch and from
+// the API server otherwise. A pod missing from the cache is reported as a
+// NotFound error, as the API server would.
+func (s *LiveState) getPod(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	namespace, name string,
+) (*v1.Pod, error) {
+	if s.cache == nil {
+		return k8sClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	pod, ok := s.cache.GetPod(namespace, name)
+	if !ok {
+		return nil, k8serrors.NewNotFound(v1.Resource("pods"), name)
+	}
+	return pod, nil
+}
+
+// listNamespaces returns all namespaces, from the informer cache under
+// --watch and from the API server otherwise.
+func (s *LiveState) listNamespaces(ctx context.Context, k8sClient kubernetes.Interface) ([]v1.Namespace, error) {
+	if s.cache != nil {
+		return s.cache.GetNamespaces(), nil
+	}
+	namespaces, err := k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		ResourceVersion: "0",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return namespaces.Items, nil
+}
+
+// listDeployments returns the deployments of namespace (all namespaces when
+// empty). Under --watch they are read from the informer cache once its
+// deployment informer, started by the first call, has synced; until then
+// they are listed from the API server.
+func (s *LiveState) listDeployments(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	namespace string,
+) ([]appsv1.Deployment, error) {
+	if s.cache != nil {
+		if deployments, ok := s.cache.GetDeployments(namespace); ok {
+			return deployments, nil
+		}
+	}
+	deployments, err := k8sClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
+		ResourceVersion: "0",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deployments.Items, nil
+}
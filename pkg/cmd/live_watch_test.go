@@ -0,0 +1,224 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func watchTestPod(namespace, name, node string, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:     namespace,
+			Name:          name,
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubelet"}},
+		},
+		Spec:   v1.PodSpec{NodeName: node},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+func startTestWatch(t *testing.T) *LiveState {
+	t.Helper()
+	client := fake.NewSimpleClientset(
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "batch"}},
+		watchTestPod("default", "web", "node-1", v1.PodRunning),
+		watchTestPod("default", "queued", "", v1.PodPending),
+		watchTestPod("batch", "done", "node-1", v1.PodSucceeded),
+	)
+	wc, err := startLiveWatch(client)
+	if err != nil {
+		t.Fatalf("startLiveWatch() error = %v", err)
+	}
+	t.Cleanup(wc.Stop)
+	return &LiveState{cache: wc}
+}
+
+func TestStartLiveWatchSyncFailure(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(v1.Resource("pods"), "", nil)
+	})
+
+	wc, err := startLiveWatchTimeout(client, 100*time.Millisecond)
+	if err == nil {
+		wc.Stop()
+		t.Fatal("startLiveWatch() succeeded although pods cannot be listed")
+	}
+	if !strings.Contains(err.Error(), "Pod informer caches did not sync") || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("startLiveWatch() error = %v", err)
+	}
+}
+
+func TestWatchCacheStartStopped(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("list", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(v1.Resource("nodes"), "", nil)
+	})
+
+	wc := NewWatchCache(client, 0)
+	time.AfterFunc(50*time.Millisecond, wc.Stop)
+	if err := wc.Start(context.Background()); err == nil || !strings.Contains(err.Error(), "Node") {
+		t.Errorf("Start() after Stop error = %v, want the Node informer", err)
+	}
+}
+
+func TestLiveStateListDeploymentsFromCache(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "batch", Name: "worker"}},
+	)
+	var lists atomic.Int32
+	client.PrependReactor("list", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		lists.Add(1)
+		return false, nil, nil
+	})
+	wc, err := startLiveWatch(client)
+	if err != nil {
+		t.Fatalf("startLiveWatch() error = %v", err)
+	}
+	t.Cleanup(wc.Stop)
+	state := &LiveState{cache: wc}
+	ctx := context.Background()
+
+	// The first read starts the deployment informer and lists from the API
+	// server until it has synced.
+	if _, err := state.listDeployments(ctx, client, ""); err != nil {
+		t.Fatalf("listDeployments() error = %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !wc.factory.Apps().V1().Deployments().Informer().HasSynced() {
+		if time.Now().After(deadline) {
+			t.Fatal("deployment informer did not sync")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	before := lists.Load()
+	deployments, err := state.listDeployments(ctx, client, "default")
+	if err != nil {
+		t.Fatalf("listDeployments() error = %v", err)
+	}
+	if len(deployments) != 1 || deployments[0].Name != "web" {
+		t.Errorf("listDeployments(default) = %v, want web", deployments)
+	}
+	if n := lists.Load() - before; n != 0 {
+		t.Errorf("listDeployments() listed from the API server %d times after the cache synced", n)
+	}
+}
+
+func podNames(pods []v1.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for _, p := range pods {
+		names = append(names, p.Namespace+"/"+p.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestLiveStateListPodsFromCache(t *testing.T) {
+	state := startTestWatch(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		namespace     string
+		fieldSelector string
+		want          []string
+	}{
+		{"all", "", "", []string{"batch/done", "default/queued", "default/web"}},
+		{"namespace", "default", "", []string{"default/queued", "default/web"}},
+		{"active", "", activePodFieldSelector, []string{"default/queued", "default/web"}},
+		{"pending", "", pendingPodFieldSelector, []string{"default/queued"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pods, err := state.listPods(ctx, nil, tt.namespace, tt.fieldSelector)
+			if err != nil {
+				t.Fatalf("listPods() error = %v", err)
+			}
+			got := podNames(pods)
+			if len(got) != len(tt.want) {
+				t.Fatalf("listPods() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("listPods() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestLiveStateCacheStripsManagedFields(t *testing.T) {
+	state := startTestWatch(t)
+
+	pods, err := state.listPods(context.Background(), nil, "", "")
+	if err != nil {
+		t.Fatalf("listPods() error = %v", err)
+	}
+	for _, p := range pods {
+		if len(p.ManagedFields) != 0 {
+			t.Errorf("pod %s/%s still has managedFields", p.Namespace, p.Name)
+		}
+	}
+}
+
+func TestLiveStateGetPodFromCache(t *testing.T) {
+	state := startTestWatch(t)
+	ctx := context.Background()
+
+	pod, err := state.getPod(ctx, nil, "default", "web")
+	if err != nil || pod.Name != "web" {
+		t.Fatalf("getPod(default/web) = %v, %v", pod, err)
+	}
+	if _, err := state.getPod(ctx, nil, "default", "gone"); !k8serrors.IsNotFound(err) {
+		t.Errorf("getPod(default/gone) error = %v, want NotFound", err)
+	}
+}
+
+func TestLiveStateListNodesAndNamespacesFromCache(t *testing.T) {
+	state := startTestWatch(t)
+	ctx := context.Background()
+
+	nodes, err := state.listNodes(ctx, nil)
+	if err != nil || len(nodes) != 1 {
+		t.Errorf("listNodes() = %d nodes, %v; want 1", len(nodes), err)
+	}
+	namespaces, err := state.listNamespaces(ctx, nil)
+	if err != nil || len(namespaces) != 2 {
+		t.Errorf("listNamespaces() = %d namespaces, %v; want 2", len(namespaces), err)
+	}
+	state.selectedNamespace = "batch"
+	if got := getNextNamespace(nil, state); got != "default" {
+		t.Errorf("getNextNamespace(batch) = %q, want default", got)
+	}
+}
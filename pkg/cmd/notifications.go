@@ -0,0 +1,159 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	"gitlab.com/davidxarnold/glance/pkg/notify"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	metricsV1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// clusterLister returns the current nodes and pods of a cluster.
+type clusterLister func(ctx context.Context) ([]v1.Node, []v1.Pod, error)
+
+// cacheLister lists from an informer cache, as used by glance serve.
+func cacheLister(cache clusterCache) clusterLister {
+	return func(context.Context) ([]v1.Node, []v1.Pod, error) {
+		return cache.GetNodes(), cache.GetPods(), nil
+	}
+}
+
+// apiLister lists from the API server, as used by glance live without
+// --watch. Each call lists every node and pod, in addition to the requests
+// the live view makes to draw itself.
+func apiLister(client kubernetes.Interface) clusterLister {
+	return func(ctx context.Context) ([]v1.Node, []v1.Pod, error) {
+		nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+		if err != nil {
+			return nil, nil, err
+		}
+		pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+		if err != nil {
+			return nil, nil, err
+		}
+		return nodes.Items, pods.Items, nil
+	}
+}
+
+// newNotifier builds a notifier from the "notifications" section of
+// ~/.glance/config. It returns nil when no webhook is configured.
+func newNotifier() (*notify.Notifier, error) {
+	var cfg notify.Config
+	if err := viper.UnmarshalKey("notifications", &cfg); err != nil {
+		return nil, fmt.Errorf("invalid notifications config: %w", err)
+	}
+	if len(cfg.Webhooks) == 0 {
+		return nil, nil
+	}
+	return notify.New(cfg)
+}
+
+// runNotifications observes the cluster every notifier interval until ctx
+// is done, delivering threshold transitions to the configured webhooks.
+func runNotifications(ctx context.Context, n *notify.Notifier, cluster string,
+	list clusterLister, metrics metricsource.Source) {
+	go n.Run(ctx)
+
+	ticker := time.NewTicker(n.Interval())
+	defer ticker.Stop()
+	for {
+		nodes, pods, err := list(ctx)
+		if err != nil {
+			log.Debugf("notify: failed to list cluster: %v", err)
+		} else {
+			nodeMetrics, podMetrics, available := fetchClusterUsage(metrics)
+			observations, err := notificationObservations(nodes, pods, nodeMetrics, podMetrics, available)
+			if err != nil {
+				log.Debugf("notify: %v", err)
+			} else {
+				for _, ev := range n.Observe(cluster, observations) {
+					log.Debugf("notify: %s %s %s %s → %s (%.1f%%)",
+						ev.Kind, ev.Name, ev.Metric, ev.Previous, ev.Level, ev.Value)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// notificationObservations computes the notifier metrics: per Ready node,
+// requests and usage as a percentage of allocatable; per namespace, usage as
+// a percentage of limits (namespaces without limits are skipped). Usage is
+// omitted when metrics are unavailable.
+func notificationObservations(
+	nodes []v1.Node,
+	pods []v1.Pod,
+	nodeMetrics map[string]*metricsV1beta1api.NodeMetrics,
+	podMetrics map[string]*metricsV1beta1api.PodMetrics,
+	metricsAvailable bool,
+) ([]notify.Observation, error) {
+	podsByNode, _ := groupPodsByNode(pods)
+	nm, _, err := core.ComputeNodeSnapshot(nodes, podsByNode, nodeMetrics, core.NodeSnapshotOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute node snapshot: %w", err)
+	}
+
+	var out []notify.Observation
+	add := func(kind, name, metric string, used, total float64) {
+		if total > 0 {
+			out = append(out, notify.Observation{Kind: kind, Name: name, Metric: metric, Value: percentOf(used, total)})
+		}
+	}
+
+	names := make([]string, 0, len(nm))
+	for name := range nm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		stats := nm[name]
+		if stats.Status != nodeStatusReady {
+			continue
+		}
+		cpu, mem := cpuCores(stats.AllocatableCPU), quantityBytes(stats.AllocatableMemory)
+		add(notify.KindNode, name, notify.MetricCPURequests, cpuCores(&stats.AllocatedCPUrequests), cpu)
+		add(notify.KindNode, name, notify.MetricMemoryRequests, quantityBytes(&stats.AllocatedMemoryRequests), mem)
+		if metricsAvailable && stats.UsageCPU != nil {
+			add(notify.KindNode, name, notify.MetricCPUUsage, cpuCores(stats.UsageCPU), cpu)
+		}
+		if metricsAvailable && stats.UsageMemory != nil {
+			add(notify.KindNode, name, notify.MetricMemoryUsage, quantityBytes(stats.UsageMemory), mem)
+		}
+	}
+
+	if metricsAvailable {
+		namespaces := core.AggregatePods(pods, podMetrics, func(p *v1.Pod) string { return p.Namespace })
+		for ns, agg := range namespaces {
+			add(notify.KindNamespace, ns, notify.MetricCPUUsage, cpuCores(&agg.CPUUsage), cpuCores(&agg.CPULimits))
+			add(notify.KindNamespace, ns, notify.MetricMemoryUsage, quantityBytes(&agg.MemoryUsage), quantityBytes(&agg.MemoryLimits))
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	"gitlab.com/davidxarnold/glance/pkg/notify"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNewNotifier(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	n, err := newNotifier()
+	if err != nil || n != nil {
+		t.Fatalf("expected no notifier without webhooks, got %v, %v", n, err)
+	}
+
+	viper.SetConfigType("yaml")
+	config := `
+notifications:
+  interval: 1m
+  cooldown: 15m
+  metrics: [cpu.usagePercent, memory.requestsPercent]
+  webhooks:
+    - url: https://hooks.slack.com/services/T/B/X
+      format: slack
+    - url: http://localhost:8080/alerts
+      headers:
+        Authorization: Bearer token
+`
+	if err := viper.ReadConfig(strings.NewReader(config)); err != nil {
+		t.Fatal(err)
+	}
+	n, err = newNotifier()
+	if err != nil || n == nil {
+		t.Fatalf("expected a notifier, got %v, %v", n, err)
+	}
+	if n.Interval() != time.Minute || !n.Watches(notify.MetricMemoryRequests) || n.Watches(notify.MetricMemoryUsage) {
+		t.Errorf("config not applied: interval %s", n.Interval())
+	}
+
+	viper.Set("notifications.webhooks", []map[string]any{{"url": "http://localhost", "format": "teams"}})
+	if _, err := newNotifier(); err == nil {
+		t.Error("expected an error for an unknown webhook format")
+	}
+}
+
+func TestNotificationObservations(t *testing.T) {
+	cache := newExporterTestCache()
+	nodeMetrics := map[string]*metricsv1beta1.NodeMetrics{
+		"node-1": {Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("3800m"), v1.ResourceMemory: resource.MustParse("3Gi")}},
+	}
+	podMetrics := map[string]*metricsv1beta1.PodMetrics{
+		metricsource.PodKey("payments", "api-1"): {Containers: []metricsv1beta1.ContainerMetrics{{
+			Name: "app", Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("3"), v1.ResourceMemory: resource.MustParse("3Gi")},
+		}}},
+	}
+
+	observations, err := notificationObservations(cache.nodes, cache.pods, nodeMetrics, podMetrics, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]float64)
+	for _, o := range observations {
+		got[o.Kind+"/"+o.Name+"/"+o.Metric] = o.Value
+	}
+	// node-1: 4 CPU, 8Gi allocatable; two scheduled pods request 500m/1Gi
+	// each. Namespace limits include the pending pod: 6 CPU, 6Gi.
+	want := map[string]float64{
+		"node/node-1/cpu.requestsPercent":        25,
+		"node/node-1/memory.requestsPercent":     25,
+		"node/node-1/cpu.usagePercent":           95,
+		"node/node-1/memory.usagePercent":        37.5,
+		"namespace/payments/cpu.usagePercent":    50,
+		"namespace/payments/memory.usagePercent": 50,
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected %d observations, got %v", len(want), got)
+	}
+	for key, v := range want {
+		if got[key] != v {
+			t.Errorf("%s = %v, want %v", key, got[key], v)
+		}
+	}
+
+	n, err := notify.New(notify.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := n.Observe("prod", observations)
+	if len(events) != 1 || events[0].Name != "node-1" || events[0].Level != notify.LevelCritical {
+		t.Errorf("expected node-1 CPU usage to be critical, got %+v", events)
+	}
+
+	observations, err = notificationObservations(cache.nodes, cache.pods, nil, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range observations {
+		if o.Kind != notify.KindNode || !strings.HasSuffix(o.Metric, "requestsPercent") {
+			t.Errorf("expected only node requests without metrics, got %+v", o)
+		}
+	}
+}
@@ -7,8 +7,8 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"k8s.io/client-go/kubernetes"
-	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
 // NewPodsCmd creates the static "glance pods" subcommand.
@@ -35,10 +35,9 @@ Respects --namespace/-n, --selector, --field-selector, and --output.`,
 				return fmt.Errorf("failed to create kubernetes client: %w", err)
 			}
 
-			metricsClient, err := metricsclientset.NewForConfig(gc.restConfig)
+			metricsMode, metricsSource, err := resolveMetricsSource(gc)
 			if err != nil {
-				log.Debugf("Failed to create metrics client for pods: %v", err)
-				metricsClient = nil
+				return err
 			}
 
 			selector, err := getLabelSelector()
@@ -54,10 +53,13 @@ Respects --namespace/-n, --selector, --field-selector, and --output.`,
 			}
 
 			ctx := context.Background()
-			rows, err := CollectPodStats(ctx, k8sClient, metricsClient, namespace, selector)
+			rows, err := CollectPodStats(ctx, k8sClient, metricsSource, namespace, selector)
 			if err != nil {
 				return fmt.Errorf("failed to collect pod stats: %w", err)
 			}
+			if err := requirePodMetrics(metricsMode, metricsSource, rows); err != nil {
+				return err
+			}
 
 			// Sort by namespace, then name for stable output.
 			sort.Slice(rows, func(i, j int) bool {
@@ -71,6 +73,11 @@ Respects --namespace/-n, --selector, --field-selector, and --output.`,
 		},
 	}
 
+	var showContainers bool
+	cmd.Flags().BoolVar(&showContainers, "containers", false,
+		"Show a per-container breakdown (requests, limits, usage, restarts) beneath each pod")
+	_ = viper.BindPFlag("show-containers", cmd.Flags().Lookup("containers"))
+
 	return cmd
 }
 
@@ -130,3 +137,123 @@ Respects --namespace/-n, --selector, --field-selector, and --output.`,
 
 	return cmd
 }
+
+// NewPendingCmd creates the static "glance pending" subcommand.
+func NewPendingCmd(gc *GlanceConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "Show unscheduled pods and why they are pending (static view)",
+		Long: `Display pods that are Pending and not yet bound to a node, together with
+their resource requests, age, PodScheduled condition message and the latest
+FailedScheduling event.
+
+This mirrors the live Pending view but runs once and exits.
+Respects --namespace/-n, --selector and --output.`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Resolve REST config to respect kube flags (context, namespace, etc.).
+			rc, err := gc.configFlags.ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get kubernetes config: %w", err)
+			}
+			gc.restConfig = rc
+
+			k8sClient, err := kubernetes.NewForConfig(gc.restConfig)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+
+			selector, err := getLabelSelector()
+			if err != nil {
+				return fmt.Errorf("invalid label/field selector: %w", err)
+			}
+
+			// Determine namespace from kubeconfig/flags; empty means all namespaces.
+			namespace, _, err := gc.configFlags.ToRawKubeConfigLoader().Namespace()
+			if err != nil {
+				log.Debugf("Failed to determine namespace for pending pods: %v", err)
+				namespace = ""
+			}
+
+			ctx := context.Background()
+			rows, err := CollectPendingPods(ctx, k8sClient, namespace, selector)
+			if err != nil {
+				return fmt.Errorf("failed to collect pending pods: %w", err)
+			}
+
+			// Oldest first: long-pending pods are the most interesting.
+			sort.Slice(rows, func(i, j int) bool {
+				if !rows[i].CreationTime.Equal(rows[j].CreationTime) {
+					return rows[i].CreationTime.Before(rows[j].CreationTime)
+				}
+				if rows[i].Namespace == rows[j].Namespace {
+					return rows[i].Name < rows[j].Name
+				}
+				return rows[i].Namespace < rows[j].Namespace
+			})
+
+			return renderPendingStatic(rows)
+		},
+	}
+
+	return cmd
+}
+
+// NewOOMCmd creates the static "glance oom" subcommand.
+func NewOOMCmd(gc *GlanceConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oom",
+		Short: "Report OOM-killed containers, most recent first",
+		Long: `Display containers whose most recent termination was an OOM kill, sorted by
+most recent kill, with the container's memory request/limit and last observed
+usage. Per-namespace and per-node OOM kill counts are printed below the report.
+
+Respects --namespace/-n, --selector, --field-selector, and --output.`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Resolve REST config to respect kube flags (context, namespace, etc.).
+			rc, err := gc.configFlags.ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get kubernetes config: %w", err)
+			}
+			gc.restConfig = rc
+
+			k8sClient, err := kubernetes.NewForConfig(gc.restConfig)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+
+			metricsMode, metricsSource, err := resolveMetricsSource(gc)
+			if err != nil {
+				return err
+			}
+
+			selector, err := getLabelSelector()
+			if err != nil {
+				return fmt.Errorf("invalid label/field selector: %w", err)
+			}
+
+			// Determine namespace from kubeconfig/flags; empty means all namespaces.
+			namespace, _, err := gc.configFlags.ToRawKubeConfigLoader().Namespace()
+			if err != nil {
+				log.Debugf("Failed to determine namespace for OOM report: %v", err)
+				namespace = ""
+			}
+
+			ctx := context.Background()
+			rows, err := CollectPodStats(ctx, k8sClient, metricsSource, namespace, selector)
+			if err != nil {
+				return fmt.Errorf("failed to collect pod stats: %w", err)
+			}
+			if err := requirePodMetrics(metricsMode, metricsSource, rows); err != nil {
+				return err
+			}
+
+			return renderOOMStatic(BuildOOMReport(rows))
+		},
+	}
+
+	return cmd
+}
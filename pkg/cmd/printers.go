@@ -0,0 +1,281 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// kubectl-style template output formats, given as "<format>=<template>".
+const (
+	outputFormatCustomColumns = "custom-columns"
+	outputFormatJSONPath      = "jsonpath"
+	outputFormatGoTemplate    = "go-template"
+)
+
+// noneValue is printed by custom-columns for fields missing from a row.
+const noneValue = "<none>"
+
+// parseTemplateOutput splits -o custom-columns=..., -o jsonpath=... and
+// -o go-template=... into the format and its template. ok is false for
+// other output formats.
+func parseTemplateOutput(output string) (format, tmpl string, ok bool) {
+	format, tmpl, found := strings.Cut(output, "=")
+	if !found {
+		return "", "", false
+	}
+	switch format {
+	case outputFormatCustomColumns, outputFormatJSONPath, outputFormatGoTemplate:
+		return format, tmpl, true
+	}
+	return "", "", false
+}
+
+// isTemplateFormat reports whether output is a kubectl-style template format.
+func isTemplateFormat(output string) bool {
+	_, _, ok := parseTemplateOutput(output)
+	return ok
+}
+
+// renderTemplateOutput evaluates the template in output against rows (a
+// slice of row models) and writes the result to stdout.
+func renderTemplateOutput(output string, rows any) error {
+	if err := writeTemplateOutput(os.Stdout, output, rows); err != nil {
+		log.Errorf("failed to render %s output: %v", output, err)
+		return err
+	}
+	return nil
+}
+
+// writeTemplateOutput is renderTemplateOutput writing to w.
+func writeTemplateOutput(w io.Writer, output string, rows any) error {
+	format, tmpl, ok := parseTemplateOutput(output)
+	if !ok {
+		return fmt.Errorf("unsupported output format %q", output)
+	}
+	if tmpl == "" {
+		return fmt.Errorf("%s output requires a template, e.g. -o %s=...", format, format)
+	}
+
+	items, err := rowObjects(rows)
+	if err != nil {
+		return fmt.Errorf("failed to convert rows for %s output: %w", format, err)
+	}
+
+	switch format {
+	case outputFormatCustomColumns:
+		return writeCustomColumns(w, tmpl, items)
+	case outputFormatJSONPath:
+		return writeJSONPath(w, tmpl, rowList(items))
+	default:
+		return writeGoTemplate(w, tmpl, rowList(items))
+	}
+}
+
+// rowObjects converts row models to generic objects keyed by their JSON
+// field names, so templates see the documented lowerCamelCase names. Unlike
+// a JSON round trip, it keeps each field's Go type: CPU and utilization stay
+// floating-point and byte counts stay integers, so jsonpath filters compare
+// consistently and large values do not print in exponent notation.
+func rowObjects(rows any) ([]any, error) {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("expected a slice of rows, got %T", rows)
+	}
+	items := make([]any, v.Len())
+	for i := range items {
+		items[i] = toRowObject(v.Index(i))
+	}
+	return items, nil
+}
+
+// toRowObject converts v following its json tags. Nil pointers become nil
+// and omitempty fields that are nil are left out; times use RFC 3339.
+func toRowObject(v reflect.Value) any {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return toRowObject(v.Elem())
+	case reflect.Struct:
+		obj := make(map[string]any, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" || name == "-" || !field.IsExported() {
+				continue
+			}
+			value := toRowObject(v.Field(i))
+			if value == nil && strings.Contains(opts, "omitempty") {
+				continue
+			}
+			obj[name] = value
+		}
+		return obj
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		list := make([]any, v.Len())
+		for i := range list {
+			list[i] = toRowObject(v.Index(i))
+		}
+		return list
+	default:
+		return v.Interface()
+	}
+}
+
+// rowList wraps items in the list object seen by jsonpath and go-template.
+func rowList(items []any) map[string]any {
+	return map[string]any{"kind": "List", "items": items}
+}
+
+// customColumn is one NAME:expression pair of a custom-columns spec.
+type customColumn struct {
+	header string
+	parser *jsonpath.JSONPath
+}
+
+// parseCustomColumns parses "NAME:.field,NAME2:.other.field". Expressions
+// may omit the braces and leading dot, as with kubectl.
+func parseCustomColumns(spec string) ([]customColumn, error) {
+	parts := strings.Split(spec, ",")
+	columns := make([]customColumn, 0, len(parts))
+	for _, part := range parts {
+		header, expr, found := strings.Cut(part, ":")
+		if !found || header == "" || expr == "" {
+			return nil, fmt.Errorf("invalid custom-columns spec %q: expected <header>:<json-path-expr>", part)
+		}
+		parser := jsonpath.New(header).AllowMissingKeys(true)
+		if err := parser.Parse(relaxedJSONPath(expr)); err != nil {
+			return nil, fmt.Errorf("invalid custom-columns expression %q: %w", expr, err)
+		}
+		columns = append(columns, customColumn{header: header, parser: parser})
+	}
+	return columns, nil
+}
+
+// relaxedJSONPath turns ".name" or "name" into "{.name}".
+func relaxedJSONPath(expr string) string {
+	if strings.HasPrefix(expr, "{") {
+		return expr
+	}
+	if !strings.HasPrefix(expr, ".") {
+		expr = "." + expr
+	}
+	return "{" + expr + "}"
+}
+
+// writeCustomColumns prints one aligned row per item. Missing fields print
+// as <none> and multiple matches are comma-separated.
+func writeCustomColumns(w io.Writer, spec string, items []any) error {
+	columns, err := parseCustomColumns(spec)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 5, 8, 3, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = c.header
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, item := range items {
+		cells := make([]string, len(columns))
+		for i, c := range columns {
+			results, err := c.parser.FindResults(item)
+			if err != nil {
+				return err
+			}
+			var values []string
+			for _, set := range results {
+				for _, v := range set {
+					values = append(values, formatJSONValue(v.Interface()))
+				}
+			}
+			cells[i] = noneValue
+			if len(values) > 0 {
+				cells[i] = strings.Join(values, ",")
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// formatJSONValue prints scalars plainly and objects as compact JSON.
+func formatJSONValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return noneValue
+	case string:
+		return v
+	case map[string]any, []any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// writeJSONPath evaluates a kubectl-style JSONPath template against the
+// row list, e.g. '{range .items[*]}{.name}{"\n"}{end}'.
+func writeJSONPath(w io.Writer, tmpl string, list map[string]any) error {
+	parser := jsonpath.New("output").AllowMissingKeys(true)
+	if err := parser.Parse(tmpl); err != nil {
+		return fmt.Errorf("invalid jsonpath template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := parser.Execute(&buf, list); err != nil {
+		return fmt.Errorf("failed to execute jsonpath template: %w", err)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeGoTemplate executes a Go text/template against the row list, e.g.
+// '{{range .items}}{{.name}}{{"\n"}}{{end}}'.
+func writeGoTemplate(w io.Writer, tmpl string, list map[string]any) error {
+	t, err := template.New("output").Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("invalid go-template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, list); err != nil {
+		return fmt.Errorf("failed to execute go-template: %w", err)
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestParseTemplateOutput(t *testing.T) {
+	tests := []struct {
+		output, format, tmpl string
+		ok                   bool
+	}{
+		{"custom-columns=NAME:.name", outputFormatCustomColumns, "NAME:.name", true},
+		{"jsonpath={.items[?(@.status==\"Ready\")].name}", outputFormatJSONPath, "{.items[?(@.status==\"Ready\")].name}", true},
+		{"go-template={{len .items}}", outputFormatGoTemplate, "{{len .items}}", true},
+		{"json", "", "", false},
+		{"pretty=1", "", "", false},
+	}
+	for _, tt := range tests {
+		format, tmpl, ok := parseTemplateOutput(tt.output)
+		if format != tt.format || tmpl != tt.tmpl || ok != tt.ok {
+			t.Errorf("parseTemplateOutput(%q) = %q, %q, %v", tt.output, format, tmpl, ok)
+		}
+	}
+}
+
+func TestWriteTemplateOutput_Nodes(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	nm, _ := newReportTestSnapshot()
+	(*nm)["node-1"].NodeGroup = "general"
+	// node-2 has no usage metrics.
+	idle := *(*nm)["node-1"]
+	idle.UsageCPU, idle.UsageMemory = nil, nil
+	(*nm)["node-2"] = &idle
+	rows := nodeRows(*nm, "")
+
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{
+			"custom-columns=NAME:.name,CPU:.usage.cpu,MEM:.requests.memory,GROUP:nodeGroup",
+			"NAME     CPU    MEM          GROUP\n" +
+				"node-1   3.8    1073741824   general\n" +
+				"node-2   <none>   1073741824   general\n",
+		},
+		{`jsonpath={range .items[*]}{.name}={.utilization.cpu}{"\n"}{end}`, "node-1=95\nnode-2=\n"},
+		{`jsonpath={.items[?(@.requests.cpu>1.0)].name}`, "node-1 node-2"},
+		{`go-template={{range .items}}{{.name}}:{{.allocatable.cpu}} {{end}}`, "node-1:4 node-2:4 "},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := writeTemplateOutput(&buf, tt.output, rows); err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.output, err)
+			continue
+		}
+		got := buf.String()
+		if strings.HasPrefix(tt.output, "custom-columns") {
+			// Compare whitespace-insensitively; alignment is tabwriter's job.
+			got, tt.want = strings.Join(strings.Fields(got), " "), strings.Join(strings.Fields(tt.want), " ")
+		}
+		if got != tt.want {
+			t.Errorf("%s:\nexpected %q\ngot      %q", tt.output, tt.want, got)
+		}
+	}
+}
+
+func TestWriteTemplateOutput_Pods(t *testing.T) {
+	cpu, limit := resource.MustParse("250m"), resource.MustParse("1")
+	rows := podRows([]PodSummaryRow{{
+		Namespace: "shop", Name: "web", CPUReq: &cpu, CPULimit: &limit, CPUUsage: &cpu,
+		Status: "Running", Restarts: 2, MetricsAvailable: true,
+	}})
+
+	var buf bytes.Buffer
+	if err := writeTemplateOutput(&buf, "custom-columns=POD:.name,NS:.namespace,REQ:.requests.cpu,USE:.usage.cpu,RESTARTS:.restarts", rows); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Fields(buf.String()); strings.Join(got[5:], " ") != "web shop 0.25 0.25 2" {
+		t.Errorf("unexpected custom-columns output:\n%s", buf.String())
+	}
+}
+
+func TestWriteTemplateOutput_Errors(t *testing.T) {
+	for _, output := range []string{
+		"custom-columns=",
+		"custom-columns=NAME",
+		"jsonpath={.items[",
+		"go-template={{.items",
+	} {
+		var buf bytes.Buffer
+		if err := writeTemplateOutput(&buf, output, []nodeRow{}); err == nil {
+			t.Errorf("%s: expected an error", output)
+		}
+	}
+}
@@ -19,10 +19,9 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	// _ "github.com/go-echarts/go-echarts/v2"
-	ui "github.com/gizak/termui/v3"
-	"github.com/gizak/termui/v3/widgets"
 	pt "github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	log "github.com/sirupsen/logrus"
@@ -85,6 +84,59 @@ func formatQuantityValue(q resource.Quantity) string {
 	return formatBytes(&q)
 }
 
+// usageNotAvailable marks usage cells when metrics are unavailable.
+const usageNotAvailable = "n/a"
+
+// metricsUnavailableBanner explains allocation-only output.
+const metricsUnavailableBanner = "Usage metrics unavailable: showing allocation only (requests/limits). " +
+	"Usage columns are marked n/a."
+
+// printMetricsBanner prints the allocation-only banner above static tables.
+func printMetricsBanner() {
+	fmt.Println("  " + text.Colors{text.FgYellow, text.Bold}.Sprint("⚠ "+metricsUnavailableBanner))
+	fmt.Println()
+}
+
+// formatUsage formats a usage quantity, returning n/a when it is unknown.
+func formatUsage(q *resource.Quantity) string {
+	if q == nil {
+		return usageNotAvailable
+	}
+	return formatQuantity(q)
+}
+
+// storageHeaders are the column titles for the optional storage and network
+// usage columns reported by the kubelet metrics source.
+var storageHeaders = pt.Row{"EPHEMERAL", "FS USED/CAP", "NET RX/TX"}
+
+// formatByteQuantity formats a byte count, honoring --raw. Nil renders empty.
+func formatByteQuantity(q *resource.Quantity) string {
+	if q == nil {
+		return ""
+	}
+	if viper.GetBool("exact") || viper.GetBool("show-raw") {
+		return q.String()
+	}
+	return formatBytes(q)
+}
+
+// formatBytePair renders "a / b", or empty when neither value is known.
+func formatBytePair(a, b *resource.Quantity) string {
+	if a == nil && b == nil {
+		return ""
+	}
+	return formatByteQuantity(a) + " / " + formatByteQuantity(b)
+}
+
+// storageCells returns the cells matching storageHeaders for a node.
+func storageCells(v *core.NodeStats) pt.Row {
+	return pt.Row{
+		formatByteQuantity(v.UsageEphemeralStorage),
+		formatBytePair(v.FSUsed, v.FSCapacity),
+		formatBytePair(v.NetworkRxBytes, v.NetworkTxBytes),
+	}
+}
+
 // formatResourceRatioFromStrings formats resource strings as ratio (used / total).
 // Converts string quantities to resource.Quantity then formats as ratio.
 // nolint:unused // Reserved for future static view ratio formatting
@@ -101,18 +153,29 @@ func formatResourceRatioFromStrings(usedStr, totalStr string, isMemory bool, sho
 	return formatResourceRatio(&used, &total, isMemory, showRaw)
 }
 
-func render(nm *core.NodeMap, c *core.Totals) error {
-	switch viper.GetString("output") {
+// render renders the node view according to the global output format. meta
+// describes the cluster in the JSON and YAML documents; namespaces is only
+// collected for the chart format.
+func render(nm *core.NodeMap, c *core.Totals, meta core.DocumentMetadata, namespaces *namespaceBreakdown) error {
+	output := viper.GetString("output")
+	if isTemplateFormat(output) {
+		return renderTemplateOutput(output, nodeRows(*nm, ""))
+	}
+	switch output {
 	case outputFormatJSON:
-		return renderJSON(nm, c)
+		return renderJSON(nm, c, meta)
 	case outputFormatPretty:
 		return renderPretty(nm, c)
-	case "chart":
-		return chart(nm)
-	case "dash":
-		return dash(nm)
-	case "pie":
-		return pie(nm)
+	case outputFormatChart:
+		return chart(nm, c, namespaces)
+	case outputFormatDash:
+		return dash(nm, c, namespaces)
+	case outputFormatPie:
+		return pie(nm, c, namespaces)
+	case outputFormatYAML:
+		return renderYAML(core.NewSnapshotDocument(core.NewSnapshot(*nm, *c), meta), "snapshot")
+	case outputFormatCSV, outputFormatMarkdown, outputFormatHTML:
+		return renderReport(output, nodeReport(nm, c))
 	default:
 		table(nm, c)
 		return nil
@@ -122,6 +185,9 @@ func render(nm *core.NodeMap, c *core.Totals) error {
 // renderPodsStatic renders pod summaries according to the global output format.
 func renderPodsStatic(rows []PodSummaryRow) error {
 	output := viper.GetString("output")
+	if isTemplateFormat(output) {
+		return renderTemplateOutput(output, podRows(rows))
+	}
 	if output == outputFormatJSON {
 		b, err := json.MarshalIndent(rows, "", "\t")
 		if err != nil {
@@ -131,6 +197,16 @@ func renderPodsStatic(rows []PodSummaryRow) error {
 		fmt.Println(string(b))
 		return nil
 	}
+	if output == outputFormatYAML {
+		return renderYAML(rows, "pods")
+	}
+	if isReportFormat(output) {
+		return renderReport(output, podReport(rows))
+	}
+
+	if len(rows) > 0 && !rows[0].MetricsAvailable {
+		printMetricsBanner()
+	}
 
 	// txt/pretty: use a simple table. We treat both the same for now.
 	t := pt.NewWriter()
@@ -154,7 +230,12 @@ func renderPodsStatic(rows []PodSummaryRow) error {
 	if showGPU {
 		headerRow = append(headerRow, "GPU REQ/LIMIT")
 	}
-	headerRow = append(headerRow, "STATUS")
+	headerRow = append(headerRow, "STATUS", "RESTARTS", "LAST TERMINATION")
+	showStorage := viper.GetBool("show-storage") || podsHaveStorage(rows)
+	if showStorage {
+		headerRow = append(headerRow, "EPHEMERAL", "NET RX/TX")
+	}
+	showContainers := viper.GetBool("show-containers")
 	t.AppendHeader(headerRow)
 
 	showRaw := viper.GetBool("show-raw") || viper.GetBool("exact")
@@ -171,9 +252,9 @@ func renderPodsStatic(rows []PodSummaryRow) error {
 			r.Namespace,
 			r.Name,
 			formatResourceRatio(cpuReq, cpuLimit, false, showRaw),
-			formatResourceRatio(cpuUsage, cpuLimit, false, showRaw),
+			formatUsageRatio(cpuUsage, cpuLimit, false, showRaw, r.MetricsAvailable),
 			formatResourceRatio(memReq, memLimit, true, showRaw),
-			formatResourceRatio(memUsage, memLimit, true, showRaw),
+			formatUsageRatio(memUsage, memLimit, true, showRaw, r.MetricsAvailable),
 		}
 		if showGPU {
 			if r.GPUReq != nil && r.GPUReq.Value() > 0 {
@@ -186,17 +267,276 @@ func renderPodsStatic(rows []PodSummaryRow) error {
 				row = append(row, "—")
 			}
 		}
-		row = append(row, r.Status)
+		row = append(row,
+			r.Status,
+			fmt.Sprintf("%d", r.Restarts),
+			formatTermination(r.LastTerminationReason, r.LastTerminationTime),
+		)
+		if showStorage {
+			row = append(row,
+				formatByteQuantity(r.EphemeralStorage),
+				formatBytePair(r.NetworkRxBytes, r.NetworkTxBytes),
+			)
+		}
 		t.AppendRow(row)
+		if !showContainers {
+			continue
+		}
+
+		for _, c := range r.Containers {
+			t.AppendRow(buildContainerRow(c, showGPU, showRaw, r.MetricsAvailable))
+		}
+	}
+
+	t.Render()
+	return nil
+}
+
+// renderPendingStatic renders unscheduled pods and their scheduling failure
+// reasons according to the global output format.
+func renderPendingStatic(rows []PendingPodRow) error {
+	output := viper.GetString("output")
+	if isTemplateFormat(output) {
+		return renderTemplateOutput(output, pendingRows(rows))
+	}
+	if output == outputFormatJSON {
+		b, err := json.MarshalIndent(rows, "", "\t")
+		if err != nil {
+			log.Errorf("failed to marshal pending pods to JSON: %v", err)
+			return fmt.Errorf("failed to render pending pods JSON output: %w", err)
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	t := pt.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	if output == outputFormatPretty {
+		t.SetStyle(pt.StyleRounded)
+	} else {
+		t.SetStyle(pt.StyleLight)
+	}
+
+	showGPU := viper.GetBool("show-gpu")
+
+	headerRow := pt.Row{"NAMESPACE", "POD", "CPU REQUESTS", "MEMORY REQUESTS"}
+	if showGPU {
+		headerRow = append(headerRow, "GPU REQUESTS")
+	}
+	headerRow = append(headerRow, "AGE", "CONDITION", "LAST FAILEDSCHEDULING EVENT")
+	t.AppendHeader(headerRow)
+
+	// Scheduler messages can be very long; wrap them instead of letting
+	// them push the table off-screen.
+	msgWidth := getTerminalWidth() / 3
+	if msgWidth < 30 {
+		msgWidth = 30
+	}
+	t.SetColumnConfigs([]pt.ColumnConfig{
+		{Name: "CONDITION", WidthMax: msgWidth},
+		{Name: "LAST FAILEDSCHEDULING EVENT", WidthMax: msgWidth},
+	})
+
+	for _, r := range rows {
+		row := pt.Row{
+			r.Namespace,
+			r.Name,
+			formatQuantity(r.CPUReq),
+			formatQuantity(r.MemReq),
+		}
+		if showGPU {
+			if r.GPUReq != nil && r.GPUReq.Value() > 0 {
+				row = append(row, fmt.Sprintf("%d", r.GPUReq.Value()))
+			} else {
+				row = append(row, "—")
+			}
+		}
+
+		age := "—"
+		if !r.CreationTime.IsZero() {
+			age = glanceutil.FormatAge(r.CreationTime)
+		}
+		row = append(row, age, pendingConditionText(r), pendingEventText(r))
+		t.AppendRow(row)
+	}
+
+	t.Render()
+	return nil
+}
+
+// pendingConditionText formats the PodScheduled condition for a pending pod.
+func pendingConditionText(r PendingPodRow) string {
+	switch {
+	case r.ConditionReason == "" && r.ConditionMessage == "":
+		return "—"
+	case r.ConditionMessage == "":
+		return r.ConditionReason
+	case r.ConditionReason == "":
+		return r.ConditionMessage
+	default:
+		return r.ConditionReason + ": " + r.ConditionMessage
+	}
+}
+
+// pendingEventText formats the latest FailedScheduling event for a pending pod,
+// including how long ago it was seen and how many times it has repeated.
+func pendingEventText(r PendingPodRow) string {
+	if r.LastEventMessage == "" {
+		return "—"
+	}
+	msg := r.LastEventMessage
+	if !r.LastEventTime.IsZero() {
+		msg = fmt.Sprintf("(%s ago", glanceutil.FormatAge(r.LastEventTime))
+		if r.FailedScheduling > 1 {
+			msg += fmt.Sprintf(", x%d", r.FailedScheduling)
+		}
+		msg += ") " + r.LastEventMessage
+	}
+	return msg
+}
+
+// podsHaveStorage reports whether any row carries storage or network usage.
+func podsHaveStorage(rows []PodSummaryRow) bool {
+	for _, r := range rows {
+		if r.EphemeralStorage != nil || r.NetworkRxBytes != nil || r.NetworkTxBytes != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildContainerRow creates an indented child row for a single container
+// beneath its pod in the static pods table. Column layout matches the pod
+// rows rendered by renderPodsStatic when --containers is enabled.
+func buildContainerRow(c ContainerSummaryRow, showGPU, showRaw, metricsAvailable bool) pt.Row {
+	row := pt.Row{
+		"",
+		"  └ " + c.Name,
+		formatResourceRatio(c.CPUReq, c.CPULimit, false, showRaw),
+		formatUsageRatio(c.CPUUsage, c.CPULimit, false, showRaw, metricsAvailable),
+		formatResourceRatio(c.MemReq, c.MemLimit, true, showRaw),
+		formatUsageRatio(c.MemUsage, c.MemLimit, true, showRaw, metricsAvailable),
+	}
+	if showGPU {
+		row = append(row, "")
+	}
+	row = append(row, "",
+		fmt.Sprintf("%d", c.RestartCount),
+		formatTermination(c.LastTerminationReason, c.LastTerminationTime))
+	return row
+}
+
+// formatTermination renders a termination reason with how long ago it
+// happened, e.g. "OOMKilled (5m ago)". It returns "—" when there is no reason.
+func formatTermination(reason string, at time.Time) string {
+	if reason == "" {
+		return "—"
+	}
+	if at.IsZero() {
+		return reason
+	}
+	return fmt.Sprintf("%s (%s ago)", reason, glanceutil.FormatAge(at))
+}
+
+// renderOOMStatic renders the OOM kill report followed by per-namespace and
+// per-node kill counts according to the global output format.
+func renderOOMStatic(kills []OOMKillRow) error {
+	output := viper.GetString("output")
+	if isTemplateFormat(output) {
+		return renderTemplateOutput(output, oomRows(kills))
 	}
+	if output == outputFormatJSON {
+		b, err := json.MarshalIndent(kills, "", "\t")
+		if err != nil {
+			log.Errorf("failed to marshal OOM report to JSON: %v", err)
+			return fmt.Errorf("failed to render OOM report JSON output: %w", err)
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	style := pt.StyleLight
+	if output == outputFormatPretty {
+		style = pt.StyleRounded
+	}
+
+	if len(kills) == 0 {
+		fmt.Println("No OOM-killed containers found.")
+		return nil
+	}
+
+	showRaw := viper.GetBool("show-raw") || viper.GetBool("exact")
 
+	t := pt.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(style)
+	t.AppendHeader(pt.Row{
+		"NAMESPACE", "POD", "CONTAINER", "NODE", "LAST OOM KILL", "RESTARTS",
+		"MEMORY REQUESTS/LIMITS", "MEMORY USAGE/LIMITS",
+	})
+
+	byNamespace := make(map[string]int)
+	byNode := make(map[string]int)
+	for _, k := range kills {
+		killedAt := "—"
+		if !k.KilledAt.IsZero() {
+			killedAt = glanceutil.FormatAge(k.KilledAt) + " ago"
+		}
+		node := k.Node
+		if node == "" {
+			node = "—"
+		}
+		t.AppendRow(pt.Row{
+			k.Namespace,
+			k.Pod,
+			k.Container,
+			node,
+			killedAt,
+			fmt.Sprintf("%d", k.RestartCount),
+			formatResourceRatio(k.MemReq, k.MemLimit, true, showRaw),
+			formatUsageRatio(k.MemUsage, k.MemLimit, true, showRaw, k.MemUsage != nil),
+		})
+		byNamespace[k.Namespace]++
+		byNode[node]++
+	}
 	t.Render()
+
+	fmt.Println()
+	renderOOMCounts("NAMESPACE", byNamespace, style)
+	fmt.Println()
+	renderOOMCounts("NODE", byNode, style)
 	return nil
 }
 
+// renderOOMCounts renders a two-column table of OOM kill counts, highest first.
+func renderOOMCounts(keyHeader string, counts map[string]int, style pt.Style) {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	t := pt.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(style)
+	t.AppendHeader(pt.Row{keyHeader, "OOM KILLED"})
+	for _, k := range keys {
+		t.AppendRow(pt.Row{k, counts[k]})
+	}
+	t.Render()
+}
+
 // renderDeploymentsStatic renders deployment summaries according to the global output format.
 func renderDeploymentsStatic(rows []DeploymentSummaryRow) error {
 	output := viper.GetString("output")
+	if isTemplateFormat(output) {
+		return renderTemplateOutput(output, deploymentRows(rows))
+	}
 	if output == outputFormatJSON {
 		b, err := json.MarshalIndent(rows, "", "\t")
 		if err != nil {
@@ -206,6 +546,12 @@ func renderDeploymentsStatic(rows []DeploymentSummaryRow) error {
 		fmt.Println(string(b))
 		return nil
 	}
+	if output == outputFormatYAML {
+		return renderYAML(rows, "deployments")
+	}
+	if isReportFormat(output) {
+		return renderReport(output, deploymentReport(rows))
+	}
 
 	t := pt.NewWriter()
 	t.SetOutputMirror(os.Stdout)
@@ -268,9 +614,10 @@ func renderDeploymentsStatic(rows []DeploymentSummaryRow) error {
 	return nil
 }
 
-func renderJSON(nm *core.NodeMap, c *core.Totals) error {
-	snapshot := core.NewSnapshot(*nm, *c)
-	g, err := json.MarshalIndent(snapshot, "", "\t")
+// renderJSON prints the versioned glance/v1 Snapshot document.
+func renderJSON(nm *core.NodeMap, c *core.Totals, meta core.DocumentMetadata) error {
+	doc := core.NewSnapshotDocument(core.NewSnapshot(*nm, *c), meta)
+	g, err := json.MarshalIndent(doc, "", "\t")
 	if err != nil {
 		log.Errorf("failed to marshal snapshot to JSON: %v", err)
 		return fmt.Errorf("failed to render JSON output: %w", err)
@@ -285,7 +632,7 @@ func buildNodeRow(
 	v *core.NodeStats,
 	status string,
 	statusColor text.Colors,
-	showVersion, showAge, showGroup, showGPU, showCloud bool,
+	showVersion, showAge, showGroup, showGPU, showStorage, showCloud bool,
 ) pt.Row {
 	row := pt.Row{
 		name,
@@ -311,6 +658,9 @@ func buildNodeRow(
 	if showGPU {
 		row = append(row, buildGPUUtilizationCell(v))
 	}
+	if showStorage {
+		row = append(row, storageCells(v)...)
+	}
 	if showCloud {
 		// Parse provider from ProviderID
 		provider := ""
@@ -350,6 +700,7 @@ func renderPretty(nm *core.NodeMap, c *core.Totals) error {
 	showAge := viper.GetBool("show-node-age")
 	showGroup := viper.GetBool("show-node-group")
 	showGPU := viper.GetBool("show-gpu")
+	showStorage := viper.GetBool("show-storage")
 
 	// Create main table
 	t := pt.NewWriter()
@@ -386,6 +737,15 @@ func renderPretty(nm *core.NodeMap, c *core.Totals) error {
 		colGPU = col
 		col++
 	}
+	colEphemeral, colFS, colNet := 0, 0, 0
+	if showStorage {
+		colEphemeral = col
+		col++
+		colFS = col
+		col++
+		colNet = col
+		col++
+	}
 
 	showCloud := viper.GetBool("show-cloud-provider")
 	colProvider, colRegion, colInstance, colCapacity := 0, 0, 0, 0
@@ -424,6 +784,13 @@ func renderPretty(nm *core.NodeMap, c *core.Totals) error {
 	if colGPU != 0 {
 		baseColumns = append(baseColumns, pt.ColumnConfig{Number: colGPU, AutoMerge: false})
 	}
+	if showStorage {
+		baseColumns = append(baseColumns,
+			pt.ColumnConfig{Number: colEphemeral, AutoMerge: false},
+			pt.ColumnConfig{Number: colFS, AutoMerge: false},
+			pt.ColumnConfig{Number: colNet, AutoMerge: false},
+		)
+	}
 
 	if showCloud {
 		baseColumns = append(baseColumns,
@@ -455,6 +822,9 @@ func renderPretty(nm *core.NodeMap, c *core.Totals) error {
 	if showGPU {
 		headerRow = append(headerRow, "GPU UTILIZATION")
 	}
+	if showStorage {
+		headerRow = append(headerRow, storageHeaders...)
+	}
 	if showCloud {
 		headerRow = append(headerRow, "PROVIDER", "REGION", "INSTANCE TYPE", "CAPACITY")
 	}
@@ -472,6 +842,7 @@ func renderPretty(nm *core.NodeMap, c *core.Totals) error {
 			showAge,
 			showGroup,
 			showGPU,
+			showStorage,
 			showCloud,
 		)
 		t.AppendRow(row)
@@ -494,6 +865,7 @@ func renderPretty(nm *core.NodeMap, c *core.Totals) error {
 			showAge,
 			showGroup,
 			showGPU,
+			showStorage,
 			showCloud,
 		)
 		t.AppendRow(row)
@@ -520,6 +892,9 @@ func renderPretty(nm *core.NodeMap, c *core.Totals) error {
 	if showGPU {
 		footerRow = append(footerRow, buildTotalGPUCell(c))
 	}
+	if showStorage {
+		footerRow = append(footerRow, "", "", "")
+	}
 	if showCloud {
 		footerRow = append(footerRow, "", "", "", "")
 	}
@@ -622,13 +997,23 @@ func printClusterSummary(nm *NodeMap, c *Totals) {
 		barWidth = 40
 	}
 
+	// Usage is unknown in allocation-only mode; say so instead of drawing
+	// empty bars that read as an idle cluster.
+	if !c.MetricsAvailable {
+		naLine := fmt.Sprintf("║  %s %s", text.Colors{text.FgYellow}.Sprint("⚠"), metricsUnavailableBanner)
+		fmt.Println(padRightDynamic(naLine, boxWidth) + "║")
+		fmt.Println("║" + strings.Repeat(" ", boxWidth) + "║")
+	}
+
 	// CPU Usage
-	cpuUsageBar := buildColoredProgressBarDynamic(cpuUsagePct, barWidth)
-	cpuLine := fmt.Sprintf("║  CPU Usage:      %s %5.1f%%  (%s / %s)",
-		cpuUsageBar, cpuUsagePct,
-		formatQuantity(c.TotalUsageCPU),
-		formatQuantity(c.TotalAllocatableCPU))
-	fmt.Println(padRightDynamic(cpuLine, boxWidth) + "║")
+	if c.MetricsAvailable {
+		cpuUsageBar := buildColoredProgressBarDynamic(cpuUsagePct, barWidth)
+		cpuLine := fmt.Sprintf("║  CPU Usage:      %s %5.1f%%  (%s / %s)",
+			cpuUsageBar, cpuUsagePct,
+			formatQuantity(c.TotalUsageCPU),
+			formatQuantity(c.TotalAllocatableCPU))
+		fmt.Println(padRightDynamic(cpuLine, boxWidth) + "║")
+	}
 
 	// CPU Allocated
 	cpuAllocBar := buildColoredProgressBarDynamic(cpuAllocPct, barWidth)
@@ -641,12 +1026,14 @@ func printClusterSummary(nm *NodeMap, c *Totals) {
 	fmt.Println("║" + strings.Repeat(" ", boxWidth) + "║")
 
 	// Memory Usage
-	memUsageBar := buildColoredProgressBarDynamic(memUsagePct, barWidth)
-	memLine := fmt.Sprintf("║  Mem Usage:      %s %5.1f%%  (%s / %s)",
-		memUsageBar, memUsagePct,
-		formatQuantity(c.TotalUsageMemory),
-		formatQuantity(c.TotalAllocatableMemory))
-	fmt.Println(padRightDynamic(memLine, boxWidth) + "║")
+	if c.MetricsAvailable {
+		memUsageBar := buildColoredProgressBarDynamic(memUsagePct, barWidth)
+		memLine := fmt.Sprintf("║  Mem Usage:      %s %5.1f%%  (%s / %s)",
+			memUsageBar, memUsagePct,
+			formatQuantity(c.TotalUsageMemory),
+			formatQuantity(c.TotalAllocatableMemory))
+		fmt.Println(padRightDynamic(memLine, boxWidth) + "║")
+	}
 
 	// Memory Allocated
 	memAllocBar := buildColoredProgressBarDynamic(memAllocPct, barWidth)
@@ -672,6 +1059,25 @@ func printClusterSummary(nm *NodeMap, c *Totals) {
 		fmt.Println(padRightDynamic(gpuAllocLine, boxWidth) + "║")
 	}
 
+	// Containers whose last termination was an OOM kill (only shown when
+	// there are any)
+	if c.TotalOOMKills > 0 {
+		fmt.Println(boxStyle.Sprint(midBorder))
+		oomLine := fmt.Sprintf("║  OOM-killed:     %s %d containers (run 'kubectl glance oom' for details)",
+			text.Colors{text.FgRed}.Sprint("●"), c.TotalOOMKills)
+		fmt.Println(padRightDynamic(oomLine, boxWidth) + "║")
+	}
+
+	// Pending demand (only shown when unscheduled pods exist)
+	if c.PendingPods > 0 {
+		fmt.Println(boxStyle.Sprint(midBorder))
+		pendingLine := fmt.Sprintf("║  Pending:        %s %d pods  (CPU %s  │  Mem %s unmet)",
+			text.Colors{text.FgYellow}.Sprint("●"), c.PendingPods,
+			formatQuantity(c.TotalPendingCPURequests),
+			formatQuantity(c.TotalPendingMemoryRequests))
+		fmt.Println(padRightDynamic(pendingLine, boxWidth) + "║")
+	}
+
 	fmt.Println(boxStyle.Sprint(botBorder))
 }
 
@@ -755,6 +1161,13 @@ func buildCPUUtilizationCell(v *NodeStats) string {
 	reqPct := calculatePercentageFromQuantities(&v.AllocatedCPUrequests, v.AllocatableCPU)
 	limPct := calculatePercentageFromQuantities(&v.AllocatedCPULimits, v.AllocatableCPU)
 
+	if v.UsageCPU == nil {
+		return fmt.Sprintf("%s %s\nReq: %s  Limit: %s",
+			buildMiniProgressBar(reqPct, 12), usageNotAvailable,
+			formatQuantityValue(v.AllocatedCPUrequests),
+			formatQuantityValue(v.AllocatedCPULimits))
+	}
+
 	// Mini progress bar (15 chars)
 	bar := buildMiniProgressBar(usagePct, 12)
 
@@ -793,6 +1206,13 @@ func buildMemUtilizationCell(v *NodeStats) string {
 	reqPct := calculatePercentageFromQuantities(&v.AllocatedMemoryRequests, v.AllocatableMemory)
 	limPct := calculatePercentageFromQuantities(&v.AllocatedMemoryLimits, v.AllocatableMemory)
 
+	if v.UsageMemory == nil {
+		return fmt.Sprintf("%s %s\nReq: %s  Lim: %s",
+			buildMiniProgressBar(reqPct, 12), usageNotAvailable,
+			formatQuantityValue(v.AllocatedMemoryRequests),
+			formatQuantityValue(v.AllocatedMemoryLimits))
+	}
+
 	// Mini progress bar (15 chars)
 	bar := buildMiniProgressBar(usagePct, 12)
 
@@ -838,6 +1258,13 @@ func buildTotalCPUCell(c *core.Totals) string {
 	usagePct := calculatePercentage(c.TotalUsageCPU, c.TotalAllocatableCPU)
 	reqPct := calculatePercentage(c.TotalAllocatedCPUrequests, c.TotalAllocatableCPU)
 
+	if !c.MetricsAvailable {
+		return fmt.Sprintf("Usage: %s  Req: %5.1f%%\n%s / %s",
+			usageNotAvailable, reqPct,
+			formatQuantity(c.TotalAllocatedCPUrequests),
+			formatQuantity(c.TotalAllocatableCPU))
+	}
+
 	return fmt.Sprintf("Usage: %5.1f%%  Req: %5.1f%%\n%s / %s",
 		usagePct, reqPct,
 		formatQuantity(c.TotalUsageCPU),
@@ -863,6 +1290,13 @@ func buildTotalMemCell(c *core.Totals) string {
 	usagePct := calculatePercentage(c.TotalUsageMemory, c.TotalAllocatableMemory)
 	reqPct := calculatePercentage(c.TotalAllocatedMemoryRequests, c.TotalAllocatableMemory)
 
+	if !c.MetricsAvailable {
+		return fmt.Sprintf("Usage: %s  Req: %5.1f%%\n%s / %s",
+			usageNotAvailable, reqPct,
+			formatQuantity(c.TotalAllocatedMemoryRequests),
+			formatQuantity(c.TotalAllocatableMemory))
+	}
+
 	return fmt.Sprintf("Usage: %5.1f%%  Req: %5.1f%%\n%s / %s",
 		usagePct, reqPct,
 		formatQuantity(c.TotalUsageMemory),
@@ -952,15 +1386,22 @@ func printLegend() {
 }
 
 // buildTableRow creates a standard table row for a single node (text output)
-func buildTableRow(name string, v *core.NodeStats, showVersion, showAge, showGroup, showGPU, showCloud bool) pt.Row {
-	// Calculate utilization percentages.
+func buildTableRow(name string, v *core.NodeStats, showVersion, showAge, showGroup, showGPU, showStorage, showCloud bool) pt.Row {
+	// Calculate utilization percentages. Usage is unknown (n/a) when the
+	// node has no metrics.
 	cpuPct := "--"
+	if v.UsageCPU == nil {
+		cpuPct = usageNotAvailable
+	}
 	if v.AllocatableCPU != nil && v.UsageCPU != nil && v.AllocatableCPU.MilliValue() > 0 {
 		pct := float64(v.UsageCPU.MilliValue()) / float64(v.AllocatableCPU.MilliValue()) * 100
 		cpuPct = fmt.Sprintf("%.1f%%", pct)
 	}
 
 	memPct := "--"
+	if v.UsageMemory == nil {
+		memPct = usageNotAvailable
+	}
 	if v.AllocatableMemory != nil && v.UsageMemory != nil && v.AllocatableMemory.Value() > 0 {
 		pct := float64(v.UsageMemory.Value()) / float64(v.AllocatableMemory.Value()) * 100
 		memPct = fmt.Sprintf("%.1f%%", pct)
@@ -990,11 +1431,11 @@ func buildTableRow(name string, v *core.NodeStats, showVersion, showAge, showGro
 	row = append(row,
 		formatQuantityValue(v.AllocatedCPUrequests),
 		formatQuantityValue(v.AllocatedCPULimits),
-		formatQuantity(v.UsageCPU),
+		formatUsage(v.UsageCPU),
 		cpuPct,
 		formatQuantityValue(v.AllocatedMemoryRequests),
 		formatQuantityValue(v.AllocatedMemoryLimits),
-		formatQuantity(v.UsageMemory),
+		formatUsage(v.UsageMemory),
 		memPct,
 	)
 
@@ -1007,6 +1448,10 @@ func buildTableRow(name string, v *core.NodeStats, showVersion, showAge, showGro
 		row = append(row, gpuReq+" / "+gpuAlloc)
 	}
 
+	if showStorage {
+		row = append(row, storageCells(v)...)
+	}
+
 	if showCloud {
 		// Parse provider from ProviderID.
 		provider := ""
@@ -1021,7 +1466,7 @@ func buildTableRow(name string, v *core.NodeStats, showVersion, showAge, showGro
 }
 
 // buildTableFooter creates the footer row for the text table
-func buildTableFooter(c *core.Totals, numNodes int, showVersion, showAge, showGroup, showGPU, showCloud bool) pt.Row {
+func buildTableFooter(c *core.Totals, numNodes int, showVersion, showAge, showGroup, showGPU, showStorage, showCloud bool) pt.Row {
 	totalCPUPct := "--"
 	if c.TotalAllocatableCPU != nil && c.TotalUsageCPU != nil && c.TotalAllocatableCPU.MilliValue() > 0 {
 		pct := float64(c.TotalUsageCPU.MilliValue()) / float64(c.TotalAllocatableCPU.MilliValue()) * 100
@@ -1034,6 +1479,13 @@ func buildTableFooter(c *core.Totals, numNodes int, showVersion, showAge, showGr
 		totalMemPct = fmt.Sprintf("%.1f%%", pct)
 	}
 
+	totalCPUUse := formatQuantity(c.TotalUsageCPU)
+	totalMemUse := formatQuantity(c.TotalUsageMemory)
+	if !c.MetricsAvailable {
+		totalCPUUse, totalCPUPct = usageNotAvailable, usageNotAvailable
+		totalMemUse, totalMemPct = usageNotAvailable, usageNotAvailable
+	}
+
 	footerRow := pt.Row{
 		"TOTALS",
 		fmt.Sprintf("%d nodes", numNodes),
@@ -1050,11 +1502,11 @@ func buildTableFooter(c *core.Totals, numNodes int, showVersion, showAge, showGr
 	footerRow = append(footerRow,
 		formatQuantity(c.TotalAllocatedCPUrequests),
 		formatQuantity(c.TotalAllocatedCPULimits),
-		formatQuantity(c.TotalUsageCPU),
+		totalCPUUse,
 		totalCPUPct,
 		formatQuantity(c.TotalAllocatedMemoryRequests),
 		formatQuantity(c.TotalAllocatedMemoryLimits),
-		formatQuantity(c.TotalUsageMemory),
+		totalMemUse,
 		totalMemPct,
 	)
 	if showGPU {
@@ -1066,6 +1518,9 @@ func buildTableFooter(c *core.Totals, numNodes int, showVersion, showAge, showGr
 		}
 		footerRow = append(footerRow, gpuTotal)
 	}
+	if showStorage {
+		footerRow = append(footerRow, "", "", "")
+	}
 	if showCloud {
 		footerRow = append(footerRow, "", "", "", "")
 	}
@@ -1103,6 +1558,9 @@ func table(nm *core.NodeMap, c *core.Totals) {
 	}
 	fmt.Println(strings.Repeat("=", 120))
 	fmt.Println()
+	if !c.MetricsAvailable {
+		printMetricsBanner()
+	}
 
 	// Decide which node columns to show. VERSION is shown by default to
 	// preserve legacy behavior unless the user explicitly disables it via
@@ -1114,6 +1572,7 @@ func table(nm *core.NodeMap, c *core.Totals) {
 	showAge := viper.GetBool("show-node-age")
 	showGroup := viper.GetBool("show-node-group")
 	showGPU := viper.GetBool("show-gpu")
+	showStorage := viper.GetBool("show-storage")
 
 	// Create main node table with borders.
 	t := pt.NewWriter()
@@ -1164,6 +1623,15 @@ func table(nm *core.NodeMap, c *core.Totals) {
 		colGPU = col
 		col++
 	}
+	colEphemeral, colFS, colNet := 0, 0, 0
+	if showStorage {
+		colEphemeral = col
+		col++
+		colFS = col
+		col++
+		colNet = col
+		col++
+	}
 
 	showCloud := viper.GetBool("show-cloud-provider")
 	colProvider, colRegion, colInstance, colCapacity := 0, 0, 0, 0
@@ -1206,6 +1674,13 @@ func table(nm *core.NodeMap, c *core.Totals) {
 	if colGPU != 0 {
 		baseColumns = append(baseColumns, pt.ColumnConfig{Number: colGPU, Align: text.AlignRight}) // GPU
 	}
+	if showStorage {
+		baseColumns = append(baseColumns,
+			pt.ColumnConfig{Number: colEphemeral, Align: text.AlignRight}, // Ephemeral storage
+			pt.ColumnConfig{Number: colFS, Align: text.AlignRight},        // Filesystem
+			pt.ColumnConfig{Number: colNet, Align: text.AlignRight},       // Network
+		)
+	}
 
 	if showCloud {
 		baseColumns = append(baseColumns,
@@ -1234,6 +1709,9 @@ func table(nm *core.NodeMap, c *core.Totals) {
 	if showGPU {
 		headerRow = append(headerRow, "GPU REQ/ALLOC")
 	}
+	if showStorage {
+		headerRow = append(headerRow, storageHeaders...)
+	}
 	if showCloud {
 		headerRow = append(headerRow, "PROVIDER", "REGION", "INSTANCE TYPE", "CAPACITY")
 	}
@@ -1242,12 +1720,12 @@ func table(nm *core.NodeMap, c *core.Totals) {
 	// Add node rows.
 	for _, name := range nodeNames {
 		v := (*nm)[name]
-		row := buildTableRow(name, v, showVersion, showAge, showGroup, showGPU, showCloud)
+		row := buildTableRow(name, v, showVersion, showAge, showGroup, showGPU, showStorage, showCloud)
 		t.AppendRow(row)
 	}
 
 	// Add totals footer.
-	footerRow := buildTableFooter(c, len(*nm), showVersion, showAge, showGroup, showGPU, showCloud)
+	footerRow := buildTableFooter(c, len(*nm), showVersion, showAge, showGroup, showGPU, showStorage, showCloud)
 
 	t.AppendSeparator()
 	t.AppendFooter(footerRow)
@@ -1267,93 +1745,9 @@ func table(nm *core.NodeMap, c *core.Totals) {
 		fmt.Printf("  Allocatable GPU:    %d\n", c.TotalAllocatableGPU.Value())
 		fmt.Printf("  Allocated GPU:      %d\n", c.TotalAllocatedGPURequests.Value())
 	}
+	if c.TotalOOMKills > 0 {
+		fmt.Printf("  OOM-killed:         %d containers\n", c.TotalOOMKills)
+	}
 	fmt.Println(strings.Repeat("-", 60))
 	fmt.Println()
 }
-
-func chart(_ *core.NodeMap) error {
-	// Chart output is not yet implemented; return a clear error instead of exiting.
-	return fmt.Errorf("chart output is not yet implemented")
-}
-
-func dash(nm *core.NodeMap) error {
-	if err := ui.Init(); err != nil {
-		return fmt.Errorf("failed to initialize termui: %w", err)
-	}
-	defer ui.Close()
-
-	cpu := widgets.NewBarChart()
-	mem := widgets.NewBarChart()
-	for k, v := range *nm {
-		cpu.Title = k + " CPU"
-		cpu.Data = []float64{
-			float64(v.AllocatedCPUrequests.ScaledValue(resource.Micro)),
-			float64(v.AllocatedCPULimits.ScaledValue(resource.Micro)),
-			float64(v.AllocatedCPULimits.ScaledValue(resource.Micro))}
-		mem.Title = k + " Memory"
-		mem.Data = []float64{
-			float64(v.AllocatedMemoryRequests.Value()),
-			float64(v.AllocatedMemoryLimits.Value()),
-			float64(v.UsageMemory.Value())}
-	}
-	cpu.Labels = []string{"Allocated", "Limits", "Usage"}
-	cpu.SetRect(0, 0, 50, 5)
-	cpu.BarWidth = 10
-	cpu.BarColors = []ui.Color{ui.ColorRed, ui.ColorGreen}
-	cpu.LabelStyles = []ui.Style{ui.NewStyle(ui.ColorBlue)}
-	cpu.NumStyles = []ui.Style{ui.NewStyle(ui.ColorYellow)}
-
-	mem.Labels = []string{"Allocated", "Limits", "Usage"}
-	mem.SetRect(0, 5, 50, 25)
-	mem.BarWidth = 10
-	mem.BarColors = []ui.Color{ui.ColorRed, ui.ColorGreen}
-	mem.LabelStyles = []ui.Style{ui.NewStyle(ui.ColorBlue)}
-	mem.NumStyles = []ui.Style{ui.NewStyle(ui.ColorYellow)}
-
-	ui.Render(cpu, mem)
-
-	uiEvents := ui.PollEvents()
-	for {
-		e := <-uiEvents
-		switch e.ID {
-		case "q", ctlC:
-			return nil
-		}
-	}
-}
-
-func pie(nm *core.NodeMap) error {
-	if err := ui.Init(); err != nil {
-		return fmt.Errorf("failed to initialize termui: %w", err)
-	}
-	defer ui.Close()
-
-	cpu := widgets.NewPieChart()
-	mem := widgets.NewPieChart()
-	for k, v := range *nm {
-		cpu.Title = k + " CPU"
-		cpu.Data = []float64{
-			float64(v.AllocatedCPUrequests.ScaledValue(resource.Micro)),
-			float64(v.AllocatedCPULimits.ScaledValue(resource.Micro)),
-			float64(v.AllocatedCPULimits.ScaledValue(resource.Micro))}
-		mem.Title = k + " Memory"
-		mem.Data = []float64{
-			float64(v.AllocatedMemoryRequests.Value()),
-			float64(v.AllocatedMemoryLimits.Value()),
-			float64(v.UsageMemory.Value())}
-	}
-
-	cpu.SetRect(0, 0, 50, 5)
-	mem.SetRect(0, 5, 50, 25)
-
-	ui.Render(cpu, mem)
-
-	uiEvents := ui.PollEvents()
-	for {
-		e := <-uiEvents
-		switch e.ID {
-		case "q", ctlC:
-			return nil
-		}
-	}
-}
@@ -258,19 +258,92 @@ func TestNodeStatsCloudInfo(t *testing.T) {
 	}
 }
 
-// helper to build a minimal NodeMap/Totals for static render tests.
-func buildTestSnapshot() (*NodeMap, *Totals) {
-	nm := make(NodeMap)
-	nm["node1"] = &NodeStats{
-		Status:       "Ready",
-		CreationTime: metav1.Now().Time,
-		NodeGroup:    "group-a",
+// testNode describes one node of a test snapshot. Quantities are parsed with
+// resource.MustParse and empty ones are left at zero; usage is only set on
+// snapshots built with metrics.
+type testNode struct {
+	name, status, group            string
+	cpu, memory                    string // allocatable
+	cpuReq, cpuLim, memReq, memLim string
+	cpuUsage, memUsage             string
+	pods                           int
+}
+
+// helper to build a NodeMap/Totals for static render tests, with totals
+// summed over all nodes.
+func buildTestSnapshot(metrics bool, nodes ...testNode) (*NodeMap, *Totals) {
+	quantity := func(s string) resource.Quantity {
+		if s == "" {
+			return resource.Quantity{}
+		}
+		return resource.MustParse(s)
+	}
+	sum := func(total **resource.Quantity, q resource.Quantity) {
+		if *total == nil {
+			*total = &resource.Quantity{}
+		}
+		(*total).Add(q)
 	}
 
-	totals := &Totals{}
+	nm := make(NodeMap)
+	totals := &Totals{MetricsAvailable: metrics}
+	for _, n := range nodes {
+		cpu, memory := quantity(n.cpu), quantity(n.memory)
+		stats := &NodeStats{
+			Status:                  n.status,
+			CreationTime:            metav1.Now().Time,
+			NodeGroup:               n.group,
+			AllocatableCPU:          &cpu,
+			AllocatableMemory:       &memory,
+			AllocatedCPUrequests:    quantity(n.cpuReq),
+			AllocatedCPULimits:      quantity(n.cpuLim),
+			AllocatedMemoryRequests: quantity(n.memReq),
+			AllocatedMemoryLimits:   quantity(n.memLim),
+			PodCount:                n.pods,
+		}
+		sum(&totals.TotalAllocatableCPU, cpu)
+		sum(&totals.TotalAllocatableMemory, memory)
+		sum(&totals.TotalAllocatedCPUrequests, stats.AllocatedCPUrequests)
+		sum(&totals.TotalAllocatedCPULimits, stats.AllocatedCPULimits)
+		sum(&totals.TotalAllocatedMemoryRequests, stats.AllocatedMemoryRequests)
+		sum(&totals.TotalAllocatedMemoryLimits, stats.AllocatedMemoryLimits)
+		if metrics {
+			cpuUsage, memUsage := quantity(n.cpuUsage), quantity(n.memUsage)
+			stats.UsageCPU, stats.UsageMemory = &cpuUsage, &memUsage
+			sum(&totals.TotalUsageCPU, cpuUsage)
+			sum(&totals.TotalUsageMemory, memUsage)
+		}
+		nm[n.name] = stats
+	}
 	return &nm, totals
 }
 
+func TestPrintClusterSummarySections(t *testing.T) {
+	nm, totals := buildTestSnapshot(true, testNode{name: "node-1", status: "Ready", cpu: "4", memory: "8Gi"})
+	pendingCPU, pendingMem := resource.MustParse("500m"), resource.MustParse("1Gi")
+	totals.TotalOOMKills = 2
+	totals.PendingPods = 1
+	totals.TotalPendingCPURequests = &pendingCPU
+	totals.TotalPendingMemoryRequests = &pendingMem
+
+	out := captureOutput(func() { printClusterSummary(nm, totals) })
+	lines := strings.Split(out, "\n")
+	for _, label := range []string{"OOM-killed:", "Pending:"} {
+		found := false
+		for i, line := range lines {
+			if strings.Contains(line, label) {
+				found = true
+				if i == 0 || !strings.Contains(lines[i-1], "╠═") {
+					t.Errorf("expected a section border above %q, got %q", label, lines[i-1])
+				}
+			}
+		}
+		if !found {
+			t.Errorf("expected %q in summary:\n%s", label, out)
+		}
+	}
+}
+
 // captureOutput captures stdout while fn runs and restores it afterwards.
 func captureOutput(fn func()) string {
 	old := os.Stdout
@@ -301,7 +374,7 @@ func ioCopy(dst *bytes.Buffer, src *os.File) (int64, error) {
 }
 
 func TestStaticTableNodeColumnVisibility(t *testing.T) {
-	nm, totals := buildTestSnapshot()
+	nm, totals := buildTestSnapshot(false, testNode{name: "node1", status: "Ready", group: "group-a"})
 
 	// Ensure viper state is clean for each subtest.
 	viper.Reset()
@@ -347,7 +420,7 @@ func TestStaticTableNodeColumnVisibility(t *testing.T) {
 }
 
 func TestPrettyStaticNodeColumnVisibility(t *testing.T) {
-	nm, totals := buildTestSnapshot()
+	nm, totals := buildTestSnapshot(false, testNode{name: "node1", status: "Ready", group: "group-a"})
 
 	viper.Reset()
 
@@ -390,3 +463,43 @@ func TestPrettyStaticNodeColumnVisibility(t *testing.T) {
 		}
 	})
 }
+
+func TestStaticTableMetricsUnavailable(t *testing.T) {
+	nm := NodeMap{
+		"node-1": &NodeStats{
+			Status:               "Ready",
+			AllocatableCPU:       resource.NewMilliQuantity(4000, resource.DecimalSI),
+			AllocatableMemory:    resource.NewQuantity(8*1024*1024*1024, resource.BinarySI),
+			AllocatedCPUrequests: *resource.NewMilliQuantity(500, resource.DecimalSI),
+		},
+	}
+	totals := &Totals{
+		TotalAllocatableCPU:    resource.NewMilliQuantity(4000, resource.DecimalSI),
+		TotalAllocatableMemory: resource.NewQuantity(8*1024*1024*1024, resource.BinarySI),
+		TotalUsageCPU:          resource.NewMilliQuantity(0, resource.DecimalSI),
+		TotalUsageMemory:       resource.NewQuantity(0, resource.BinarySI),
+	}
+
+	viper.Reset()
+	out := captureOutput(func() { table(&nm, totals) })
+	if !strings.Contains(out, "Usage metrics unavailable") {
+		t.Errorf("expected allocation-only banner, got:\n%s", out)
+	}
+	if !strings.Contains(out, usageNotAvailable) {
+		t.Errorf("expected usage columns marked %q", usageNotAvailable)
+	}
+
+	totals.MetricsAvailable = true
+	out = captureOutput(func() { table(&nm, totals) })
+	if strings.Contains(out, "Usage metrics unavailable") {
+		t.Errorf("did not expect banner when metrics are available")
+	}
+
+	data, err := json.Marshal(totals)
+	if err != nil {
+		t.Fatalf("marshal totals: %v", err)
+	}
+	if !strings.Contains(string(data), `"metricsAvailable":true`) {
+		t.Errorf("expected metricsAvailable in JSON, got %s", data)
+	}
+}
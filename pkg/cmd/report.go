@@ -0,0 +1,555 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+)
+
+// Document output formats for the node, pods and deployments views.
+const (
+	outputFormatCSV      = "csv"
+	outputFormatYAML     = "yaml"
+	outputFormatMarkdown = "markdown"
+	outputFormatHTML     = "html"
+)
+
+// isReportFormat reports whether output is rendered from a tabularReport.
+func isReportFormat(output string) bool {
+	switch output {
+	case outputFormatCSV, outputFormatMarkdown, outputFormatHTML:
+		return true
+	}
+	return false
+}
+
+// reportColumn describes one column of a tabularReport.
+type reportColumn struct {
+	Header  string // shown in Markdown and HTML
+	Key     string // CSV header; carries the unit, e.g. cpu_requests_cores
+	Numeric bool   // right-aligned in Markdown and HTML
+}
+
+// reportCell is one value in a tabularReport.
+type reportCell struct {
+	Text  string  // human-readable, as in the terminal tables
+	Value string  // machine-readable for CSV: cores, bytes or plain numbers
+	Bar   float64 // utilization percentage drawn as a bar in HTML; < 0 for none
+}
+
+// tabularReport is a single table rendered as CSV, Markdown or HTML. The
+// cells carry both the formatted and the raw value so that every format is
+// built from the same rows.
+type tabularReport struct {
+	Title     string
+	Subtitle  string
+	Generated time.Time
+	Notes     []string
+	Columns   []reportColumn
+	Rows      [][]reportCell
+	Footer    []reportCell // totals; omitted from CSV
+}
+
+// renderReport writes r to stdout in the given document format.
+func renderReport(output string, r *tabularReport) error {
+	var err error
+	switch output {
+	case outputFormatCSV:
+		err = writeReportCSV(os.Stdout, r)
+	case outputFormatMarkdown:
+		err = writeReportMarkdown(os.Stdout, r)
+	case outputFormatHTML:
+		err = writeReportHTML(os.Stdout, r)
+	default:
+		err = fmt.Errorf("unsupported report format %q", output)
+	}
+	if err != nil {
+		log.Errorf("failed to render %s output: %v", output, err)
+		return fmt.Errorf("failed to render %s output: %w", output, err)
+	}
+	return nil
+}
+
+// renderYAML writes v to stdout as YAML. It goes through the JSON encoding,
+// so field names and quantities match -o json.
+func renderYAML(v any, what string) error {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		log.Errorf("failed to marshal %s to YAML: %v", what, err)
+		return fmt.Errorf("failed to render %s YAML output: %w", what, err)
+	}
+	fmt.Print(string(b))
+	return nil
+}
+
+// writeReportCSV writes the column keys and raw values, one row per line.
+func writeReportCSV(w io.Writer, r *tabularReport) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(r.Columns))
+	for i, c := range r.Columns {
+		header[i] = c.Key
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		record := make([]string, len(row))
+		for i, cell := range row {
+			record[i] = cell.Value
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeReportMarkdown writes a GitHub-flavored Markdown table, suitable for
+// pasting into pull requests and wikis. The footer becomes a bold last row.
+func writeReportMarkdown(w io.Writer, r *tabularReport) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "### %s\n\n", r.Title)
+	if r.Subtitle != "" {
+		fmt.Fprintf(&b, "%s  \n", markdownEscape(r.Subtitle))
+	}
+	fmt.Fprintf(&b, "_Generated %s_\n\n", r.Generated.Format(time.RFC3339))
+	for _, note := range r.Notes {
+		fmt.Fprintf(&b, "> ⚠ %s\n\n", markdownEscape(note))
+	}
+
+	headers := make([]string, len(r.Columns))
+	aligns := make([]string, len(r.Columns))
+	for i, c := range r.Columns {
+		headers[i] = markdownEscape(c.Header)
+		aligns[i] = "---"
+		if c.Numeric {
+			aligns[i] = "--:"
+		}
+	}
+	fmt.Fprintf(&b, "| %s |\n", strings.Join(headers, " | "))
+	fmt.Fprintf(&b, "|%s|\n", strings.Join(aligns, "|"))
+
+	writeRow := func(row []reportCell, bold bool) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = markdownEscape(cell.Text)
+			if bold && cells[i] != "" {
+				cells[i] = "**" + cells[i] + "**"
+			}
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+	}
+	for _, row := range r.Rows {
+		writeRow(row, false)
+	}
+	if len(r.Footer) > 0 {
+		writeRow(r.Footer, true)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownEscape escapes characters that would break a Markdown table cell.
+func markdownEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// reportHTMLTemplate is a self-contained page: styles are inline and the
+// utilization bars are drawn in CSS, so the file can be attached or mailed.
+var reportHTMLTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"barClass": barClass,
+	"cellOf":   htmlCellOf,
+	"barWidth": func(pct float64) string { return strconv.FormatFloat(math.Min(pct, 100), 'f', 1, 64) + "%" },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>glance: {{.Title}}</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #1f2328; }
+  h1 { font-size: 1.4rem; margin-bottom: .25rem; }
+  .meta { color: #656d76; font-size: .85rem; margin-bottom: 1rem; }
+  .note { background: #fff8c5; border: 1px solid #d4a72c; padding: .5rem .75rem; margin-bottom: 1rem; font-size: .85rem; }
+  table { border-collapse: collapse; font-size: .85rem; }
+  th, td { padding: .35rem .7rem; border-bottom: 1px solid #d0d7de; text-align: left; white-space: nowrap; }
+  th { background: #f6f8fa; }
+  td.num, th.num { text-align: right; }
+  tfoot td { font-weight: bold; border-top: 2px solid #d0d7de; }
+  .bar { display: inline-block; width: 60px; height: .65rem; background: #eaeef2; border-radius: 2px; overflow: hidden; vertical-align: middle; margin-right: .4rem; }
+  .bar span { display: block; height: 100%; }
+  .ok { background: #2da44e; }
+  .medium { background: #d4a72c; }
+  .high { background: #cf222e; }
+</style>
+</head>
+<body>
+<h1>glance: {{.Title}}</h1>
+<div class="meta">{{if .Subtitle}}{{.Subtitle}} · {{end}}Generated {{.Generated.Format "2006-01-02 15:04:05 MST"}}</div>
+{{range .Notes}}<div class="note">⚠ {{.}}</div>
+{{end}}<table>
+<thead><tr>{{range .Columns}}<th{{if .Numeric}} class="num"{{end}}>{{.Header}}</th>{{end}}</tr></thead>
+<tbody>
+{{range .Rows}}<tr>{{range $i, $c := .}}{{template "cell" (cellOf $ $i $c)}}{{end}}</tr>
+{{end}}</tbody>
+{{if .Footer}}<tfoot><tr>{{range $i, $c := .Footer}}{{template "cell" (cellOf $ $i $c)}}{{end}}</tr></tfoot>
+{{end}}</table>
+</body>
+</html>
+{{define "cell"}}<td{{if .Numeric}} class="num"{{end}}>{{if ge .Bar 0.0}}<span class="bar"><span class="{{barClass .Bar}}" style="width: {{barWidth .Bar}}"></span></span>{{end}}{{.Text}}</td>{{end}}
+`))
+
+// htmlCell pairs a cell with the alignment of its column for the template.
+type htmlCell struct {
+	reportCell
+	Numeric bool
+}
+
+// htmlCellOf returns cell i of a row together with its column alignment.
+func htmlCellOf(r *tabularReport, i int, c reportCell) htmlCell {
+	return htmlCell{reportCell: c, Numeric: i < len(r.Columns) && r.Columns[i].Numeric}
+}
+
+// barClass maps a utilization percentage to the CSS color used for its bar,
+// using the same thresholds as the live view.
+func barClass(pct float64) string {
+	switch {
+	case pct >= thresholdHigh:
+		return "high"
+	case pct >= thresholdMedium:
+		return "medium"
+	default:
+		return "ok"
+	}
+}
+
+// writeReportHTML writes r as a standalone HTML page.
+func writeReportHTML(w io.Writer, r *tabularReport) error {
+	return reportHTMLTemplate.Execute(w, r)
+}
+
+// Cell constructors.
+
+func stringCell(s string) reportCell {
+	return reportCell{Text: s, Value: s, Bar: -1}
+}
+
+func intCell(n int64) reportCell {
+	s := strconv.FormatInt(n, 10)
+	return reportCell{Text: s, Value: s, Bar: -1}
+}
+
+// unknownCell marks usage that could not be read: n/a in documents, empty
+// in CSV so spreadsheets do not mistake it for zero.
+func unknownCell() reportCell {
+	return reportCell{Text: usageNotAvailable, Bar: -1}
+}
+
+// cpuCell formats a CPU quantity; of, when positive, draws a bar of q/of.
+func cpuCell(q, of *resource.Quantity) reportCell {
+	cell := reportCell{Text: formatCPUValue(q), Value: "0", Bar: -1}
+	if q != nil {
+		cell.Value = strconv.FormatFloat(float64(q.MilliValue())/1000, 'f', -1, 64)
+	}
+	if of != nil && q != nil && of.MilliValue() > 0 {
+		cell.Bar = float64(q.MilliValue()) / float64(of.MilliValue()) * 100
+	}
+	return cell
+}
+
+// memCell formats a memory quantity in bytes; of, when positive, draws a
+// bar of q/of.
+func memCell(q, of *resource.Quantity) reportCell {
+	cell := reportCell{Text: formatMemValue(q), Value: "0", Bar: -1}
+	if q != nil {
+		cell.Value = strconv.FormatInt(q.Value(), 10)
+	}
+	if of != nil && q != nil && of.Value() > 0 {
+		cell.Bar = float64(q.Value()) / float64(of.Value()) * 100
+	}
+	return cell
+}
+
+// countCell formats a device count such as GPUs.
+func countCell(q *resource.Quantity) reportCell {
+	if q == nil {
+		return intCell(0)
+	}
+	return intCell(q.Value())
+}
+
+// formatCPUValue formats CPU like the terminal tables, honoring --raw.
+func formatCPUValue(q *resource.Quantity) string {
+	if viper.GetBool("exact") || viper.GetBool("show-raw") {
+		if q == nil {
+			return "0"
+		}
+		return q.String()
+	}
+	return formatMilliCPU(q)
+}
+
+// formatMemValue formats memory like the terminal tables, honoring --raw.
+func formatMemValue(q *resource.Quantity) string {
+	if viper.GetBool("exact") || viper.GetBool("show-raw") {
+		if q == nil {
+			return "0"
+		}
+		return q.String()
+	}
+	return formatBytes(q)
+}
+
+// Report builders for the node, pods and deployments views.
+
+// nodeReport builds the node table with a totals footer. Request and usage
+// bars are measured against allocatable.
+func nodeReport(nm *core.NodeMap, c *core.Totals) *tabularReport {
+	showGPU := viper.GetBool("show-gpu")
+
+	r := &tabularReport{
+		Title:     "Nodes",
+		Subtitle:  strings.Trim(c.ClusterInfo.Host+" "+c.ClusterInfo.MasterVersion, " "),
+		Generated: time.Now(),
+		Columns: []reportColumn{
+			{Header: "NODE", Key: "node"},
+			{Header: "STATUS", Key: "status"},
+			{Header: "CPU ALLOCATABLE", Key: "cpu_allocatable_cores", Numeric: true},
+			{Header: "CPU REQUESTS", Key: "cpu_requests_cores", Numeric: true},
+			{Header: "CPU LIMITS", Key: "cpu_limits_cores", Numeric: true},
+			{Header: "CPU USAGE", Key: "cpu_usage_cores", Numeric: true},
+			{Header: "MEMORY ALLOCATABLE", Key: "memory_allocatable_bytes", Numeric: true},
+			{Header: "MEMORY REQUESTS", Key: "memory_requests_bytes", Numeric: true},
+			{Header: "MEMORY LIMITS", Key: "memory_limits_bytes", Numeric: true},
+			{Header: "MEMORY USAGE", Key: "memory_usage_bytes", Numeric: true},
+		},
+	}
+	if showGPU {
+		r.Columns = append(r.Columns,
+			reportColumn{Header: "GPU ALLOCATABLE", Key: "gpu_allocatable", Numeric: true},
+			reportColumn{Header: "GPU REQUESTS", Key: "gpu_requests", Numeric: true},
+		)
+	}
+	r.Columns = append(r.Columns, reportColumn{Header: "PODS", Key: "pods", Numeric: true})
+	if !c.MetricsAvailable {
+		r.Notes = append(r.Notes, metricsUnavailableBanner)
+	}
+
+	names := make([]string, 0, len(*nm))
+	for name := range *nm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := (*nm)[name]
+		status := v.Status
+		if status == "" {
+			status = "Unknown"
+		}
+		cpuReq, cpuLim := v.AllocatedCPUrequests, v.AllocatedCPULimits
+		memReq, memLim := v.AllocatedMemoryRequests, v.AllocatedMemoryLimits
+		row := []reportCell{
+			stringCell(name),
+			stringCell(status),
+			cpuCell(v.AllocatableCPU, nil),
+			cpuCell(&cpuReq, v.AllocatableCPU),
+			cpuCell(&cpuLim, nil),
+			usageCPUCell(v.UsageCPU, v.AllocatableCPU),
+			memCell(v.AllocatableMemory, nil),
+			memCell(&memReq, v.AllocatableMemory),
+			memCell(&memLim, nil),
+			usageMemCell(v.UsageMemory, v.AllocatableMemory),
+		}
+		if showGPU {
+			gpuReq := v.AllocatedGPURequests
+			row = append(row, countCell(v.AllocatableGPU), countCell(&gpuReq))
+		}
+		row = append(row, intCell(int64(v.PodCount)))
+		r.Rows = append(r.Rows, row)
+	}
+
+	cpuUse, memUse := unknownCell(), unknownCell()
+	if c.MetricsAvailable {
+		cpuUse = cpuCell(c.TotalUsageCPU, c.TotalAllocatableCPU)
+		memUse = memCell(c.TotalUsageMemory, c.TotalAllocatableMemory)
+	}
+	r.Footer = []reportCell{
+		stringCell("TOTALS"),
+		stringCell(fmt.Sprintf("%d nodes", len(names))),
+		cpuCell(c.TotalAllocatableCPU, nil),
+		cpuCell(c.TotalAllocatedCPUrequests, c.TotalAllocatableCPU),
+		cpuCell(c.TotalAllocatedCPULimits, nil),
+		cpuUse,
+		memCell(c.TotalAllocatableMemory, nil),
+		memCell(c.TotalAllocatedMemoryRequests, c.TotalAllocatableMemory),
+		memCell(c.TotalAllocatedMemoryLimits, nil),
+		memUse,
+	}
+	if showGPU {
+		r.Footer = append(r.Footer, countCell(c.TotalAllocatableGPU), countCell(c.TotalAllocatedGPURequests))
+	}
+	r.Footer = append(r.Footer, stringCell(""))
+
+	return r
+}
+
+// usageCPUCell is cpuCell for usage that may be unknown (nil).
+func usageCPUCell(q, of *resource.Quantity) reportCell {
+	if q == nil {
+		return unknownCell()
+	}
+	return cpuCell(q, of)
+}
+
+// usageMemCell is memCell for usage that may be unknown (nil).
+func usageMemCell(q, of *resource.Quantity) reportCell {
+	if q == nil {
+		return unknownCell()
+	}
+	return memCell(q, of)
+}
+
+// podReport builds the pods table. Usage bars are measured against limits,
+// as in the live pods view.
+func podReport(rows []PodSummaryRow) *tabularReport {
+	showGPU := viper.GetBool("show-gpu")
+
+	r := &tabularReport{
+		Title:     "Pods",
+		Generated: time.Now(),
+		Columns: []reportColumn{
+			{Header: "NAMESPACE", Key: "namespace"},
+			{Header: "POD", Key: "pod"},
+			{Header: "STATUS", Key: "status"},
+			{Header: "NODE", Key: "node"},
+			{Header: "CPU REQUESTS", Key: "cpu_requests_cores", Numeric: true},
+			{Header: "CPU LIMITS", Key: "cpu_limits_cores", Numeric: true},
+			{Header: "CPU USAGE", Key: "cpu_usage_cores", Numeric: true},
+			{Header: "MEMORY REQUESTS", Key: "memory_requests_bytes", Numeric: true},
+			{Header: "MEMORY LIMITS", Key: "memory_limits_bytes", Numeric: true},
+			{Header: "MEMORY USAGE", Key: "memory_usage_bytes", Numeric: true},
+		},
+	}
+	if showGPU {
+		r.Columns = append(r.Columns,
+			reportColumn{Header: "GPU REQUESTS", Key: "gpu_requests", Numeric: true},
+			reportColumn{Header: "GPU LIMITS", Key: "gpu_limits", Numeric: true},
+		)
+	}
+	r.Columns = append(r.Columns,
+		reportColumn{Header: "RESTARTS", Key: "restarts", Numeric: true},
+		reportColumn{Header: "LAST TERMINATION", Key: "last_termination"},
+	)
+	if len(rows) > 0 && !rows[0].MetricsAvailable {
+		r.Notes = append(r.Notes, metricsUnavailableBanner)
+	}
+
+	for _, p := range rows {
+		cpuUse, memUse := unknownCell(), unknownCell()
+		if p.MetricsAvailable {
+			cpuUse = cpuCell(p.CPUUsage, p.CPULimit)
+			memUse = memCell(p.MemUsage, p.MemLimit)
+		}
+		row := []reportCell{
+			stringCell(p.Namespace),
+			stringCell(p.Name),
+			stringCell(p.Status),
+			stringCell(p.NodeName),
+			cpuCell(p.CPUReq, nil),
+			cpuCell(p.CPULimit, nil),
+			cpuUse,
+			memCell(p.MemReq, nil),
+			memCell(p.MemLimit, nil),
+			memUse,
+		}
+		if showGPU {
+			row = append(row, countCell(p.GPUReq), countCell(p.GPULimit))
+		}
+		termination := stringCell(formatTermination(p.LastTerminationReason, p.LastTerminationTime))
+		termination.Value = p.LastTerminationReason
+		row = append(row, intCell(int64(p.Restarts)), termination)
+		r.Rows = append(r.Rows, row)
+	}
+
+	return r
+}
+
+// deploymentReport builds the deployments table. Request bars are measured
+// against limits, as in the live deployments view.
+func deploymentReport(rows []DeploymentSummaryRow) *tabularReport {
+	showGPU := viper.GetBool("show-gpu")
+
+	r := &tabularReport{
+		Title:     "Deployments",
+		Generated: time.Now(),
+		Columns: []reportColumn{
+			{Header: "NAMESPACE", Key: "namespace"},
+			{Header: "DEPLOYMENT", Key: "deployment"},
+			{Header: "STATUS", Key: "status"},
+			{Header: "REPLICAS", Key: "replicas", Numeric: true},
+			{Header: "READY", Key: "ready", Numeric: true},
+			{Header: "AVAILABLE", Key: "available", Numeric: true},
+			{Header: "CPU REQUESTS", Key: "cpu_requests_cores", Numeric: true},
+			{Header: "CPU LIMITS", Key: "cpu_limits_cores", Numeric: true},
+			{Header: "MEMORY REQUESTS", Key: "memory_requests_bytes", Numeric: true},
+			{Header: "MEMORY LIMITS", Key: "memory_limits_bytes", Numeric: true},
+		},
+	}
+	if showGPU {
+		r.Columns = append(r.Columns,
+			reportColumn{Header: "GPU REQUESTS", Key: "gpu_requests", Numeric: true},
+			reportColumn{Header: "GPU LIMITS", Key: "gpu_limits", Numeric: true},
+		)
+	}
+
+	for _, d := range rows {
+		row := []reportCell{
+			stringCell(d.Namespace),
+			stringCell(d.Name),
+			stringCell(d.Status),
+			intCell(int64(d.Replicas)),
+			intCell(int64(d.Ready)),
+			intCell(int64(d.Available)),
+			cpuCell(d.CPUReq, d.CPULimit),
+			cpuCell(d.CPULimit, nil),
+			memCell(d.MemReq, d.MemLimit),
+			memCell(d.MemLimit, nil),
+		}
+		if showGPU {
+			row = append(row, countCell(d.GPUReq), countCell(d.GPULimit))
+		}
+		r.Rows = append(r.Rows, row)
+	}
+
+	return r
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func newReportTestSnapshot() (*core.NodeMap, *core.Totals) {
+	nm, totals := buildTestSnapshot(true, testNode{name: "node-1", status: "Ready", cpu: "4", memory: "8Gi",
+		cpuReq: "1500m", cpuLim: "2", memReq: "1Gi", memLim: "2Gi", cpuUsage: "3800m", memUsage: "2Gi", pods: 3})
+	totals.ClusterInfo = core.ClusterInfo{Host: "https://k8s.example", MasterVersion: "v1.31.2"}
+	return nm, totals
+}
+
+func TestNodeReport_CSV(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	nm, totals := newReportTestSnapshot()
+
+	var buf bytes.Buffer
+	if err := writeReportCSV(&buf, nodeReport(nm, totals)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected header and one node row (no totals), got %d records", len(records))
+	}
+	row := make(map[string]string)
+	for i, key := range records[0] {
+		row[key] = records[1][i]
+	}
+	want := map[string]string{
+		"node":                  "node-1",
+		"cpu_requests_cores":    "1.5",
+		"cpu_usage_cores":       "3.8",
+		"memory_requests_bytes": "1073741824",
+		"pods":                  "3",
+	}
+	for key, v := range want {
+		if row[key] != v {
+			t.Errorf("expected %s=%q, got %q", key, v, row[key])
+		}
+	}
+}
+
+func TestNodeReport_MetricsUnavailable(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	nm, totals := newReportTestSnapshot()
+	(*nm)["node-1"].UsageCPU = nil
+	(*nm)["node-1"].UsageMemory = nil
+	totals.MetricsAvailable = false
+
+	r := nodeReport(nm, totals)
+	if len(r.Notes) != 1 {
+		t.Errorf("expected the allocation-only note, got %v", r.Notes)
+	}
+	var buf bytes.Buffer
+	if err := writeReportCSV(&buf, r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Unknown usage is empty in CSV rather than a misleading zero.
+	if !strings.Contains(buf.String(), "Ready,4,1.5,2,,") {
+		t.Errorf("expected empty usage cells, got:\n%s", buf.String())
+	}
+}
+
+func TestWriteReportMarkdown(t *testing.T) {
+	r := &tabularReport{
+		Title:   "Pods",
+		Columns: []reportColumn{{Header: "POD"}, {Header: "CPU", Numeric: true}},
+		Rows:    [][]reportCell{{stringCell("a|b"), stringCell("250m")}},
+		Footer:  []reportCell{stringCell("TOTALS"), stringCell("250m")},
+	}
+	var buf bytes.Buffer
+	if err := writeReportMarkdown(&buf, r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"### Pods", "| POD | CPU |", "|---|--:|", `| a\|b | 250m |`, "| **TOTALS** | **250m** |"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected markdown to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteReportHTML(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	nm, totals := newReportTestSnapshot()
+	(*nm)["<script>"] = (*nm)["node-1"]
+
+	var buf bytes.Buffer
+	if err := writeReportHTML(&buf, nodeReport(nm, totals)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	// 3.8 of 4 cores is above the high threshold; 1.5 of 4 is below medium.
+	for _, want := range []string{"<style>", `class="high" style="width: 95.0%"`, `class="ok" style="width: 37.5%"`, "TOTALS", "&lt;script&gt;"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected HTML to contain %q", want)
+		}
+	}
+	if strings.Contains(out, "<script>") {
+		t.Error("expected node names to be escaped")
+	}
+}
+
+func TestRenderPodsStatic_YAMLAndCSV(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	cpu := resource.MustParse("250m")
+	rows := []PodSummaryRow{{Namespace: "shop", Name: "web", CPUReq: &cpu, Status: "Running", MetricsAvailable: true}}
+
+	viper.Set("output", outputFormatYAML)
+	out := captureOutput(func() {
+		if err := renderPodsStatic(rows); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	if !strings.Contains(out, "Name: web") || !strings.Contains(out, "CPUReq: 250m") {
+		t.Errorf("unexpected YAML output:\n%s", out)
+	}
+
+	viper.Set("output", outputFormatCSV)
+	out = captureOutput(func() {
+		if err := renderPodsStatic(rows); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+	if !strings.HasPrefix(out, "namespace,pod,status,node,cpu_requests_cores") || !strings.Contains(out, "shop,web,Running,,0.25,") {
+		t.Errorf("unexpected CSV output:\n%s", out)
+	}
+}
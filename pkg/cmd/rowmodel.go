@@ -0,0 +1,380 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"sort"
+	"time"
+
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// The row model is the per-row object that -o custom-columns, -o jsonpath
+// and -o go-template are evaluated against. Field names are lowerCamelCase,
+// CPU is in cores, memory in bytes and GPUs a device count, so expressions
+// such as .usage.cpu or .requests.memory do not need to parse quantities.
+// jsonpath and go-template see a list, {"kind": "List", "items": [...]}, as
+// with kubectl. The model is documented in the README; keep it in sync.
+
+// rowResources holds CPU, memory and GPU amounts of a row.
+type rowResources struct {
+	CPU    float64 `json:"cpu"`
+	Memory int64   `json:"memory"`
+	GPU    int64   `json:"gpu"`
+}
+
+// rowUsage holds observed CPU and memory usage. It is omitted from a row
+// when usage metrics are unavailable.
+type rowUsage struct {
+	CPU    float64 `json:"cpu"`
+	Memory int64   `json:"memory"`
+}
+
+// rowUtilization holds usage as a percentage of allocatable.
+type rowUtilization struct {
+	CPU    float64 `json:"cpu"`
+	Memory float64 `json:"memory"`
+}
+
+// nodeRow is the row model of the node view.
+type nodeRow struct {
+	Kind         string          `json:"kind"` // "Node"
+	Context      string          `json:"context,omitempty"`
+	Name         string          `json:"name"`
+	Status       string          `json:"status"`
+	Version      string          `json:"version"`
+	NodeGroup    string          `json:"nodeGroup,omitempty"`
+	InstanceType string          `json:"instanceType,omitempty"`
+	Region       string          `json:"region,omitempty"`
+	Created      time.Time       `json:"created"`
+	Pods         int             `json:"pods"`
+	OOMKills     int             `json:"oomKills"`
+	Allocatable  rowResources    `json:"allocatable"`
+	Requests     rowResources    `json:"requests"`
+	Limits       rowResources    `json:"limits"`
+	Usage        *rowUsage       `json:"usage,omitempty"`
+	Utilization  *rowUtilization `json:"utilization,omitempty"`
+}
+
+// podRow is the row model of "glance pods".
+type podRow struct {
+	Kind            string       `json:"kind"` // "Pod"
+	Namespace       string       `json:"namespace"`
+	Name            string       `json:"name"`
+	Node            string       `json:"node"`
+	Status          string       `json:"status"`
+	Restarts        int32        `json:"restarts"`
+	OOMKills        int          `json:"oomKills"`
+	LastTermination string       `json:"lastTermination"`
+	Requests        rowResources `json:"requests"`
+	Limits          rowResources `json:"limits"`
+	Usage           *rowUsage    `json:"usage,omitempty"`
+}
+
+// deploymentRow is the row model of "glance deployments".
+type deploymentRow struct {
+	Kind      string       `json:"kind"` // "Deployment"
+	Namespace string       `json:"namespace"`
+	Name      string       `json:"name"`
+	Status    string       `json:"status"`
+	Replicas  int32        `json:"replicas"`
+	Ready     int32        `json:"ready"`
+	Available int32        `json:"available"`
+	Requests  rowResources `json:"requests"`
+	Limits    rowResources `json:"limits"`
+}
+
+// pendingRow is the row model of "glance pending".
+type pendingRow struct {
+	Kind             string       `json:"kind"` // "PendingPod"
+	Namespace        string       `json:"namespace"`
+	Name             string       `json:"name"`
+	Created          time.Time    `json:"created"`
+	Requests         rowResources `json:"requests"`
+	Reason           string       `json:"reason"`
+	Message          string       `json:"message"`
+	LastEvent        string       `json:"lastEvent"`
+	FailedScheduling int32        `json:"failedScheduling"`
+}
+
+// oomRow is the row model of "glance oom".
+type oomRow struct {
+	Kind      string       `json:"kind"` // "OOMKill"
+	Namespace string       `json:"namespace"`
+	Pod       string       `json:"pod"`
+	Container string       `json:"container"`
+	Node      string       `json:"node"`
+	KilledAt  time.Time    `json:"killedAt"`
+	Restarts  int32        `json:"restarts"`
+	Requests  rowResources `json:"requests"`
+	Limits    rowResources `json:"limits"`
+	Usage     *rowUsage    `json:"usage,omitempty"`
+}
+
+// compareSideRow is one cluster's side of a compareRow.
+type compareSideRow struct {
+	Count    int32        `json:"count"` // nodes, pods or replicas
+	Ready    int32        `json:"ready"` // deployments only
+	Requests rowResources `json:"requests"`
+	Limits   rowResources `json:"limits"`
+	Usage    *rowUsage    `json:"usage,omitempty"`
+}
+
+// compareRow is the row model of "glance compare": one node group,
+// namespace or deployment aligned across the two clusters. left or right
+// is absent when the item only exists in the other cluster.
+type compareRow struct {
+	Kind         string          `json:"kind"`    // "Compare"
+	Section      string          `json:"section"` // "nodeGroup", "namespace" or "deployment"
+	Name         string          `json:"name"`
+	LeftContext  string          `json:"leftContext"`
+	RightContext string          `json:"rightContext"`
+	Drift        bool            `json:"drift"`
+	Left         *compareSideRow `json:"left,omitempty"`
+	Right        *compareSideRow `json:"right,omitempty"`
+}
+
+// checkRow is the row model of "glance check": one rule and its outcome.
+// Values are in the rule's metric unit, as in the JSON report.
+type checkRow struct {
+	Kind       string           `json:"kind"` // "CheckResult"
+	Rule       string           `json:"rule"`
+	Scope      string           `json:"scope"`
+	Metric     string           `json:"metric"`
+	Warn       *float64         `json:"warn,omitempty"`
+	Fail       *float64         `json:"fail,omitempty"`
+	Status     string           `json:"status"`
+	Message    string           `json:"message"`
+	Checked    int              `json:"checked"`
+	Violations []checkViolation `json:"violations"`
+}
+
+// quantityInt returns q as an integer (bytes or a device count); nil is zero.
+func quantityInt(q *resource.Quantity) int64 {
+	if q == nil {
+		return 0
+	}
+	return q.Value()
+}
+
+// newRowResources converts request- or limit-style quantities.
+func newRowResources(cpu, memory, gpu *resource.Quantity) rowResources {
+	return rowResources{CPU: cpuCores(cpu), Memory: quantityInt(memory), GPU: quantityInt(gpu)}
+}
+
+// newRowUsage converts usage quantities, returning nil when usage is unknown.
+func newRowUsage(cpu, memory *resource.Quantity, available bool) *rowUsage {
+	if !available {
+		return nil
+	}
+	return &rowUsage{CPU: cpuCores(cpu), Memory: quantityInt(memory)}
+}
+
+// percentOf returns used as a percentage of total, or 0 when total is zero.
+func percentOf(used, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return used / total * 100
+}
+
+// nodeRows converts a node snapshot to row models sorted by node name.
+func nodeRows(nm core.NodeMap, contextName string) []nodeRow {
+	names := make([]string, 0, len(nm))
+	for name := range nm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rows := make([]nodeRow, 0, len(names))
+	for _, name := range names {
+		v := nm[name]
+		row := nodeRow{
+			Kind:         "Node",
+			Context:      contextName,
+			Name:         name,
+			Status:       v.Status,
+			Version:      v.NodeInfo.KubeletVersion,
+			NodeGroup:    v.NodeGroup,
+			InstanceType: v.InstanceType,
+			Region:       v.Region,
+			Created:      v.CreationTime,
+			Pods:         v.PodCount,
+			OOMKills:     v.OOMKills,
+			Allocatable:  newRowResources(v.AllocatableCPU, v.AllocatableMemory, v.AllocatableGPU),
+			Requests:     newRowResources(&v.AllocatedCPUrequests, &v.AllocatedMemoryRequests, &v.AllocatedGPURequests),
+			Limits:       newRowResources(&v.AllocatedCPULimits, &v.AllocatedMemoryLimits, &v.AllocatedGPULimits),
+		}
+		if v.UsageCPU != nil && v.UsageMemory != nil {
+			row.Usage = newRowUsage(v.UsageCPU, v.UsageMemory, true)
+			row.Utilization = &rowUtilization{
+				CPU:    percentOf(row.Usage.CPU, row.Allocatable.CPU),
+				Memory: percentOf(float64(row.Usage.Memory), float64(row.Allocatable.Memory)),
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// podRows converts pod summaries to row models.
+func podRows(pods []PodSummaryRow) []podRow {
+	rows := make([]podRow, 0, len(pods))
+	for _, p := range pods {
+		rows = append(rows, podRow{
+			Kind:            "Pod",
+			Namespace:       p.Namespace,
+			Name:            p.Name,
+			Node:            p.NodeName,
+			Status:          p.Status,
+			Restarts:        p.Restarts,
+			OOMKills:        p.OOMKills,
+			LastTermination: p.LastTerminationReason,
+			Requests:        newRowResources(p.CPUReq, p.MemReq, p.GPUReq),
+			Limits:          newRowResources(p.CPULimit, p.MemLimit, p.GPULimit),
+			Usage:           newRowUsage(p.CPUUsage, p.MemUsage, p.MetricsAvailable),
+		})
+	}
+	return rows
+}
+
+// deploymentRows converts deployment summaries to row models.
+func deploymentRows(deployments []DeploymentSummaryRow) []deploymentRow {
+	rows := make([]deploymentRow, 0, len(deployments))
+	for _, d := range deployments {
+		rows = append(rows, deploymentRow{
+			Kind:      "Deployment",
+			Namespace: d.Namespace,
+			Name:      d.Name,
+			Status:    d.Status,
+			Replicas:  d.Replicas,
+			Ready:     d.Ready,
+			Available: d.Available,
+			Requests:  newRowResources(d.CPUReq, d.MemReq, d.GPUReq),
+			Limits:    newRowResources(d.CPULimit, d.MemLimit, d.GPULimit),
+		})
+	}
+	return rows
+}
+
+// pendingRows converts pending pods to row models.
+func pendingRows(pending []PendingPodRow) []pendingRow {
+	rows := make([]pendingRow, 0, len(pending))
+	for _, p := range pending {
+		rows = append(rows, pendingRow{
+			Kind:             "PendingPod",
+			Namespace:        p.Namespace,
+			Name:             p.Name,
+			Created:          p.CreationTime,
+			Requests:         newRowResources(p.CPUReq, p.MemReq, p.GPUReq),
+			Reason:           p.ConditionReason,
+			Message:          p.ConditionMessage,
+			LastEvent:        p.LastEventMessage,
+			FailedScheduling: p.FailedScheduling,
+		})
+	}
+	return rows
+}
+
+// oomRows converts OOM kills to row models.
+func oomRows(kills []OOMKillRow) []oomRow {
+	rows := make([]oomRow, 0, len(kills))
+	for _, k := range kills {
+		row := oomRow{
+			Kind:      "OOMKill",
+			Namespace: k.Namespace,
+			Pod:       k.Pod,
+			Container: k.Container,
+			Node:      k.Node,
+			KilledAt:  k.KilledAt,
+			Restarts:  k.RestartCount,
+			Requests:  newRowResources(nil, k.MemReq, nil),
+			Limits:    newRowResources(nil, k.MemLimit, nil),
+		}
+		if k.MemUsage != nil {
+			row.Usage = &rowUsage{Memory: k.MemUsage.Value()}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// compareRows converts a comparison report to row models, node groups
+// first, then namespaces and deployments.
+func compareRows(report CompareReport) []compareRow {
+	side := func(v *CompareValues, count int32) *compareSideRow {
+		row := &compareSideRow{
+			Count:    count,
+			Ready:    v.Ready,
+			Requests: newRowResources(v.CPUReq, v.MemReq, nil),
+			Limits:   newRowResources(v.CPULimit, v.MemLimit, nil),
+		}
+		if v.CPUUsage != nil || v.MemUsage != nil {
+			row.Usage = newRowUsage(v.CPUUsage, v.MemUsage, true)
+		}
+		return row
+	}
+	sections := []struct {
+		name  string
+		rows  []CompareRow
+		count func(v *CompareValues) int32
+	}{
+		{"nodeGroup", report.NodeGroups, func(v *CompareValues) int32 { return int32(v.Nodes) }},
+		{"namespace", report.Namespaces, func(v *CompareValues) int32 { return int32(v.Pods) }},
+		{"deployment", report.Deployments, func(v *CompareValues) int32 { return v.Replicas }},
+	}
+
+	var rows []compareRow
+	for _, sec := range sections {
+		for _, r := range sec.rows {
+			row := compareRow{
+				Kind:         "Compare",
+				Section:      sec.name,
+				Name:         r.Name,
+				LeftContext:  report.Left,
+				RightContext: report.Right,
+				Drift:        r.Drift,
+			}
+			if r.Left != nil {
+				row.Left = side(r.Left, sec.count(r.Left))
+			}
+			if r.Right != nil {
+				row.Right = side(r.Right, sec.count(r.Right))
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// checkRows converts check results to row models, in policy order.
+func checkRows(results []checkResult) []checkRow {
+	rows := make([]checkRow, 0, len(results))
+	for _, r := range results {
+		rows = append(rows, checkRow{
+			Kind:       "CheckResult",
+			Rule:       r.Rule,
+			Scope:      r.Scope,
+			Metric:     r.Metric,
+			Warn:       r.Warn,
+			Fail:       r.Fail,
+			Status:     r.Status,
+			Message:    r.Message,
+			Checked:    r.Checked,
+			Violations: r.Violations,
+		})
+	}
+	return rows
+}
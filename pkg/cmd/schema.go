@@ -0,0 +1,41 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+)
+
+// NewSchemaCmd creates the "glance schema" subcommand, which prints the JSON
+// Schema of the -o json document.
+func NewSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the glance/v1 snapshot document",
+		Long: `Print the JSON Schema (draft 2020-12) of the document written by
+"glance -o json" (kind Snapshot) and by fleet views (kind SnapshotList).
+
+The schema is stable for apiVersion glance/v1: fields may be added in later
+releases, but are never renamed, removed or changed in type.`,
+		Example:       `  kubectl glance schema > glance-v1.schema.json`,
+		Args:          cobra.NoArgs,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := cmd.OutOrStdout().Write(core.SnapshotSchema)
+			return err
+		},
+	}
+}
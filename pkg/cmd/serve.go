@@ -0,0 +1,189 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	defaultServeListen = ":9753"
+	defaultServeResync = 10 * time.Minute
+	// serveShutdownTimeout bounds graceful shutdown of in-flight requests.
+	serveShutdownTimeout = 5 * time.Second
+)
+
+// NewServeCmd creates the "glance serve" subcommand.
+func NewServeCmd(gc *GlanceConfig) *cobra.Command {
+	var listen string
+	var resync time.Duration
+	var serveAPI bool
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve glance figures as Prometheus metrics and a JSON API",
+		Long: `Keep an informer-backed view of the cluster and expose the values glance
+computes as Prometheus metrics on /metrics:
+
+  - per node: allocatable, requests, limits, usage, overcommit ratios, pod slots
+  - per namespace and per workload (Deployment, StatefulSet, DaemonSet, ...):
+    pods, requests, limits and usage
+  - cluster totals, pending pods and whether usage metrics are available
+
+Figures are computed on every scrape by the same code as the CLI. Usage comes
+from --metrics-source; with --metrics=off only allocation is exported.
+
+With --http the same server also exposes a JSON API backed by the CLI
+collectors, and a web dashboard at /:
+
+  /api/v1/snapshot                glance/v1 Snapshot document (as glance -o json)
+  /api/v1/schema                  JSON Schema of the snapshot document
+  /api/v1/namespaces              per-namespace requests, limits and usage
+  /api/v1/pods?namespace=NS       pods (all namespaces when NS is empty)
+  /api/v1/deployments?namespace=NS
+                                  deployments
+
+When ~/.glance/config has a notifications section, threshold transitions of
+nodes and namespaces are also sent to the configured webhooks.`,
+		Example: `  kubectl glance serve --listen :9753
+  kubectl glance serve --http`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rc, err := gc.configFlags.ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("failed to get kubernetes config: %w", err)
+			}
+			gc.restConfig = rc
+
+			k8sClient, err := kubernetes.NewForConfig(gc.restConfig)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+
+			metricsMode, metricsSource, err := resolveMetricsSource(gc)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if metricsMode == metricsModeRequired {
+				if _, err := metricsSource.NodeMetrics(ctx); err != nil {
+					return metricsRequiredError(metricsSource, err)
+				}
+			}
+
+			wc := NewWatchCache(k8sClient, resync)
+			if err := wc.Start(ctx); err != nil {
+				return fmt.Errorf("failed to start informers: %w", err)
+			}
+			defer wc.Stop()
+
+			notifier, err := newNotifier()
+			if err != nil {
+				return err
+			}
+			if notifier != nil {
+				contextName, _ := getContextAndCluster(gc)
+				go runNotifications(ctx, notifier, contextName, cacheLister(wc), metricsSource)
+			}
+
+			registry := prometheus.NewRegistry()
+			registry.MustRegister(newGlanceCollector(wc, metricsSource))
+
+			var api *apiServer
+			if serveAPI {
+				api = &apiServer{
+					client:        k8sClient,
+					metricsSource: metricsSource,
+					metricsMode:   metricsMode,
+					snapshot: func(ctx context.Context) (*core.SnapshotDocument, error) {
+						cs, err := collectClusterSnapshot(ctx, k8sClient, gc)
+						if err != nil {
+							return nil, err
+						}
+						doc := core.NewSnapshotDocument(cs.Snapshot, cs.documentMetadata())
+						return &doc, nil
+					},
+				}
+			}
+
+			return serveHTTP(ctx, listen, newServeMux(registry, api))
+		},
+	}
+
+	cmd.Flags().StringVar(&listen, "listen", defaultServeListen, "Address to serve /metrics on")
+	cmd.Flags().DurationVar(&resync, "resync", defaultServeResync, "Informer resync period")
+	cmd.Flags().BoolVar(&serveAPI, "http", false, "Also serve the JSON API under /api/v1/ and the web dashboard at /")
+
+	return cmd
+}
+
+// newServeMux returns the handler serving /metrics and /healthz, plus the
+// JSON API and web dashboard when api is non-nil.
+func newServeMux(registry *prometheus.Registry, api *apiServer) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok\n"))
+	})
+	if api != nil {
+		api.register(mux)
+	}
+	return mux
+}
+
+// serveHTTP serves handler on listen until ctx is canceled, then shuts the
+// server down gracefully.
+func serveHTTP(ctx context.Context, listen string, handler http.Handler) error {
+	srv := &http.Server{
+		Addr:              listen,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Infof("Serving on %s", listen)
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("failed to serve on %s: %w", listen, err)
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
@@ -9,16 +9,19 @@ package cmd
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
-	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
 // PodSummaryRow holds the textual columns and metrics for a single pod in a static view.
@@ -34,6 +37,41 @@ type PodSummaryRow struct {
 	GPUReq    *resource.Quantity
 	GPULimit  *resource.Quantity
 	Status    string
+	NodeName  string `json:",omitempty"`
+	// Restarts is the sum of restart counts across all containers.
+	Restarts int32
+	// LastTerminationReason and LastTerminationTime describe the most recent
+	// container termination in the pod (e.g. OOMKilled, Error), or "Evicted"
+	// if the pod itself was evicted.
+	LastTerminationReason string    `json:",omitempty"`
+	LastTerminationTime   time.Time `json:",omitzero"`
+	OOMKills              int       `json:",omitempty"`
+	// EphemeralStorage and the network counters are only reported by
+	// metrics sources that implement metricsource.ExtendedSource.
+	EphemeralStorage *resource.Quantity    `json:",omitempty"`
+	NetworkRxBytes   *resource.Quantity    `json:",omitempty"`
+	NetworkTxBytes   *resource.Quantity    `json:",omitempty"`
+	Containers       []ContainerSummaryRow `json:",omitempty"`
+	// MetricsAvailable is false when usage could not be read, in which
+	// case the usage fields are zero and should be shown as unknown.
+	MetricsAvailable bool `json:"metricsAvailable"`
+	// Labels are matched by the live view filter and not part of the output.
+	Labels map[string]string `json:"-"`
+}
+
+// ContainerSummaryRow holds per-container resources, usage, and restart
+// information for a single container within a pod.
+type ContainerSummaryRow struct {
+	Name                  string
+	CPUReq                *resource.Quantity
+	CPULimit              *resource.Quantity
+	CPUUsage              *resource.Quantity
+	MemReq                *resource.Quantity
+	MemLimit              *resource.Quantity
+	MemUsage              *resource.Quantity
+	RestartCount          int32
+	LastTerminationReason string    `json:",omitempty"`
+	LastTerminationTime   time.Time `json:",omitzero"`
 }
 
 // DeploymentSummaryRow holds the textual columns and metrics for a single deployment in a static view.
@@ -50,6 +88,56 @@ type DeploymentSummaryRow struct {
 	GPUReq    *resource.Quantity
 	GPULimit  *resource.Quantity
 	Status    string
+	// Labels are matched by the live view filter and not part of the output.
+	Labels map[string]string `json:"-"`
+}
+
+// NamespaceSummaryRow holds the aggregated requests, limits and usage of the
+// non-terminated pods in one namespace.
+type NamespaceSummaryRow struct {
+	Namespace string
+	Pods      int
+	CPUReq    *resource.Quantity
+	CPULimit  *resource.Quantity
+	CPUUsage  *resource.Quantity
+	MemReq    *resource.Quantity
+	MemLimit  *resource.Quantity
+	MemUsage  *resource.Quantity
+	// MetricsAvailable is false when usage could not be read, in which
+	// case the usage fields are zero and should be shown as unknown.
+	MetricsAvailable bool `json:"metricsAvailable"`
+}
+
+// PendingPodRow describes a single unscheduled pod and why the scheduler
+// could not place it.
+type PendingPodRow struct {
+	Namespace        string
+	Name             string
+	CPUReq           *resource.Quantity
+	MemReq           *resource.Quantity
+	GPUReq           *resource.Quantity
+	CreationTime     time.Time
+	ConditionReason  string    `json:",omitempty"`
+	ConditionMessage string    `json:",omitempty"`
+	LastEventMessage string    `json:",omitempty"`
+	LastEventTime    time.Time `json:",omitzero"`
+	FailedScheduling int32     `json:",omitempty"` // FailedScheduling event count
+	// Labels are matched by the live view filter and not part of the output.
+	Labels map[string]string `json:"-"`
+}
+
+// OOMKillRow describes a container whose most recent termination was an OOM
+// kill, together with its memory limit and last observed usage.
+type OOMKillRow struct {
+	Namespace    string
+	Pod          string
+	Container    string
+	Node         string `json:",omitempty"`
+	KilledAt     time.Time
+	RestartCount int32
+	MemReq       *resource.Quantity
+	MemLimit     *resource.Quantity
+	MemUsage     *resource.Quantity
 }
 
 // CollectPodStats aggregates pod-level resource stats for a given namespace and optional selectors.
@@ -57,7 +145,7 @@ type DeploymentSummaryRow struct {
 func CollectPodStats(
 	ctx context.Context,
 	k8sClient kubernetes.Interface,
-	metricsClient metricsclientset.Interface,
+	metricsSource metricsource.Source,
 	namespace string,
 	selector labels.Selector,
 ) ([]PodSummaryRow, error) {
@@ -74,28 +162,42 @@ func CollectPodStats(
 		return nil, err
 	}
 
+	return summarizePods(ctx, pods.Items, metricsSource, namespace), nil
+}
+
+// summarizePods builds one PodSummaryRow per pod, joining usage for
+// namespace (all namespaces when empty) from metricsSource. It is the part
+// of CollectPodStats shared with the live TUI's informer cache.
+func summarizePods(
+	ctx context.Context,
+	pods []v1.Pod,
+	metricsSource metricsource.Source,
+	namespace string,
+) []PodSummaryRow {
 	// Try to fetch metrics for the same namespace; failure is logged but not fatal.
-	var podMetricsList *metricsv1beta1.PodMetricsList
-	if metricsClient != nil {
-		listOpts := metav1.ListOptions{ResourceVersion: "0"}
-		podMetricsList, err = metricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, listOpts)
+	var err error
+	var metricsMap map[string]*metricsv1beta1.PodMetrics
+	metricsAvailable := false
+	if metricsSource != nil {
+		metricsMap, err = metricsSource.PodMetrics(ctx, namespace)
 		if err != nil {
-			log.Debugf("Failed to fetch pod metrics for namespace %s: %v", namespace, err)
+			log.Debugf("Failed to fetch pod metrics from %s for namespace %s: %v", metricsSource.Name(), namespace, err)
+		} else {
+			metricsAvailable = true
 		}
 	}
-
-	metricsMap := make(map[string]*metricsv1beta1.PodMetrics)
-	if podMetricsList != nil {
-		for i := range podMetricsList.Items {
-			pm := &podMetricsList.Items[i]
-			metricsMap[pm.Name] = pm
+	var extendedMap map[string]metricsource.ExtendedUsage
+	if ext, ok := metricsSource.(metricsource.ExtendedSource); ok {
+		extendedMap, err = ext.PodExtendedUsage(ctx, namespace)
+		if err != nil {
+			log.Debugf("Failed to fetch pod storage usage from %s for namespace %s: %v", metricsSource.Name(), namespace, err)
 		}
 	}
 
-	rows := make([]PodSummaryRow, 0, len(pods.Items))
+	rows := make([]PodSummaryRow, 0, len(pods))
 
-	for i := range pods.Items {
-		pod := &pods.Items[i]
+	for i := range pods {
+		pod := &pods[i]
 
 		cpuReq := resource.NewMilliQuantity(0, resource.DecimalSI)
 		cpuLimit := resource.NewMilliQuantity(0, resource.DecimalSI)
@@ -132,7 +234,8 @@ func CollectPodStats(
 			}
 		}
 
-		if pm, ok := metricsMap[pod.Name]; ok {
+		pm := metricsMap[metricsource.PodKey(pod.Namespace, pod.Name)]
+		if pm != nil {
 			for _, container := range pm.Containers {
 				cpuUsage.Add(container.Usage[v1.ResourceCPU])
 				memUsage.Add(container.Usage[v1.ResourceMemory])
@@ -140,25 +243,157 @@ func CollectPodStats(
 		}
 
 		status := string(pod.Status.Phase)
+		if core.IsEvicted(pod) {
+			status = core.ReasonEvicted
+		}
+
+		containers := collectContainerStats(pod, pm)
 
 		row := PodSummaryRow{
-			Namespace: pod.Namespace,
-			Name:      pod.Name,
-			CPUReq:    cpuReq,
-			CPULimit:  cpuLimit,
-			CPUUsage:  cpuUsage,
-			MemReq:    memReq,
-			MemLimit:  memLimit,
-			MemUsage:  memUsage,
-			GPUReq:    gpuReq,
-			GPULimit:  gpuLimit,
-			Status:    status,
+			Namespace:  pod.Namespace,
+			Name:       pod.Name,
+			Labels:     pod.Labels,
+			CPUReq:     cpuReq,
+			CPULimit:   cpuLimit,
+			CPUUsage:   cpuUsage,
+			MemReq:     memReq,
+			MemLimit:   memLimit,
+			MemUsage:   memUsage,
+			GPUReq:     gpuReq,
+			GPULimit:   gpuLimit,
+			Status:     status,
+			NodeName:   pod.Spec.NodeName,
+			OOMKills:   core.CountOOMKills(pod),
+			Containers: containers,
+
+			MetricsAvailable: metricsAvailable,
+		}
+
+		for _, c := range containers {
+			row.Restarts += c.RestartCount
+			if c.LastTerminationReason != "" && !c.LastTerminationTime.Before(row.LastTerminationTime) {
+				row.LastTerminationReason = c.LastTerminationReason
+				row.LastTerminationTime = c.LastTerminationTime
+			}
+		}
+		if core.IsEvicted(pod) {
+			row.LastTerminationReason = core.ReasonEvicted
+		}
+		if u, ok := extendedMap[metricsource.PodKey(pod.Namespace, pod.Name)]; ok {
+			row.EphemeralStorage = u.EphemeralStorage
+			row.NetworkRxBytes = u.NetworkRxBytes
+			row.NetworkTxBytes = u.NetworkTxBytes
 		}
 
 		rows = append(rows, row)
 	}
 
-	return rows, nil
+	return rows
+}
+
+// collectContainerStats builds one ContainerSummaryRow per container in the
+// pod spec, joining usage from pod metrics and restart information from the
+// container statuses by container name. pm may be nil when metrics are
+// unavailable, in which case usage is reported as zero.
+func collectContainerStats(pod *v1.Pod, pm *metricsv1beta1.PodMetrics) []ContainerSummaryRow {
+	usageByName := make(map[string]v1.ResourceList)
+	if pm != nil {
+		for _, c := range pm.Containers {
+			usageByName[c.Name] = c.Usage
+		}
+	}
+
+	statusByName := make(map[string]*v1.ContainerStatus, len(pod.Status.ContainerStatuses))
+	for i := range pod.Status.ContainerStatuses {
+		cs := &pod.Status.ContainerStatuses[i]
+		statusByName[cs.Name] = cs
+	}
+
+	containers := make([]ContainerSummaryRow, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		cr := ContainerSummaryRow{
+			Name:     container.Name,
+			CPUReq:   resource.NewMilliQuantity(0, resource.DecimalSI),
+			CPULimit: resource.NewMilliQuantity(0, resource.DecimalSI),
+			CPUUsage: resource.NewMilliQuantity(0, resource.DecimalSI),
+			MemReq:   resource.NewQuantity(0, resource.BinarySI),
+			MemLimit: resource.NewQuantity(0, resource.BinarySI),
+			MemUsage: resource.NewQuantity(0, resource.BinarySI),
+		}
+
+		if req := container.Resources.Requests.Cpu(); req != nil {
+			cr.CPUReq.Add(*req)
+		}
+		if lim := container.Resources.Limits.Cpu(); lim != nil {
+			cr.CPULimit.Add(*lim)
+		}
+		if req := container.Resources.Requests.Memory(); req != nil {
+			cr.MemReq.Add(*req)
+		}
+		if lim := container.Resources.Limits.Memory(); lim != nil {
+			cr.MemLimit.Add(*lim)
+		}
+
+		if usage, ok := usageByName[container.Name]; ok {
+			cr.CPUUsage.Add(usage[v1.ResourceCPU])
+			cr.MemUsage.Add(usage[v1.ResourceMemory])
+		}
+
+		if cs, ok := statusByName[container.Name]; ok {
+			cr.RestartCount = cs.RestartCount
+			if term := core.LastTermination(cs); term != nil {
+				cr.LastTerminationReason = term.Reason
+				cr.LastTerminationTime = term.FinishedAt.Time
+			}
+		}
+
+		containers = append(containers, cr)
+	}
+
+	return containers
+}
+
+// BuildOOMReport extracts OOM-killed containers from pod summaries, sorted by
+// most recent kill first.
+func BuildOOMReport(pods []PodSummaryRow) []OOMKillRow {
+	var kills []OOMKillRow
+	for _, p := range pods {
+		for _, c := range p.Containers {
+			if c.LastTerminationReason != core.ReasonOOMKilled {
+				continue
+			}
+			memUsage := c.MemUsage
+			if !p.MetricsAvailable {
+				memUsage = nil
+			}
+			kills = append(kills, OOMKillRow{
+				Namespace:    p.Namespace,
+				Pod:          p.Name,
+				Container:    c.Name,
+				Node:         p.NodeName,
+				KilledAt:     c.LastTerminationTime,
+				RestartCount: c.RestartCount,
+				MemReq:       c.MemReq,
+				MemLimit:     c.MemLimit,
+				MemUsage:     memUsage,
+			})
+		}
+	}
+
+	sort.SliceStable(kills, func(i, j int) bool {
+		if !kills[i].KilledAt.Equal(kills[j].KilledAt) {
+			return kills[i].KilledAt.After(kills[j].KilledAt)
+		}
+		if kills[i].Namespace != kills[j].Namespace {
+			return kills[i].Namespace < kills[j].Namespace
+		}
+		if kills[i].Pod != kills[j].Pod {
+			return kills[i].Pod < kills[j].Pod
+		}
+		return kills[i].Container < kills[j].Container
+	})
+
+	return kills
 }
 
 // CollectDeploymentStats aggregates deployment-level resource stats for a given namespace and optional selectors.
@@ -180,11 +415,17 @@ func CollectDeploymentStats(
 	if err != nil {
 		return nil, err
 	}
+	return summarizeDeployments(deployments.Items), nil
+}
 
-	rows := make([]DeploymentSummaryRow, 0, len(deployments.Items))
+// summarizeDeployments aggregates the resource stats of deployments. It is
+// the part of CollectDeploymentStats shared with the live TUI's informer
+// cache.
+func summarizeDeployments(deployments []appsv1.Deployment) []DeploymentSummaryRow {
+	rows := make([]DeploymentSummaryRow, 0, len(deployments))
 
-	for i := range deployments.Items {
-		deploy := &deployments.Items[i]
+	for i := range deployments {
+		deploy := &deployments[i]
 
 		cpuReq := resource.NewMilliQuantity(0, resource.DecimalSI)
 		cpuLimit := resource.NewMilliQuantity(0, resource.DecimalSI)
@@ -242,6 +483,7 @@ func CollectDeploymentStats(
 		row := DeploymentSummaryRow{
 			Namespace: deploy.Namespace,
 			Name:      deploy.Name,
+			Labels:    deploy.Labels,
 			Replicas:  replicas,
 			Ready:     deploy.Status.ReadyReplicas,
 			Available: deploy.Status.AvailableReplicas,
@@ -257,5 +499,201 @@ func CollectDeploymentStats(
 		rows = append(rows, row)
 	}
 
+	return rows
+}
+
+// CollectNamespaceStats aggregates the pods of every namespace (or only of
+// namespace, when set) into one row per namespace, sorted by name. Usage is
+// read from metricsSource when available; failure is logged but not fatal.
+func CollectNamespaceStats(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	metricsSource metricsource.Source,
+	namespace string,
+) ([]NamespaceSummaryRow, error) {
+	pods, err := k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, err
+	}
+
+	var metricsMap map[string]*metricsv1beta1.PodMetrics
+	metricsAvailable := false
+	if metricsSource != nil {
+		metricsMap, err = metricsSource.PodMetrics(ctx, namespace)
+		if err != nil {
+			log.Debugf("Failed to fetch pod metrics from %s for namespace %s: %v", metricsSource.Name(), namespace, err)
+		} else {
+			metricsAvailable = true
+		}
+	}
+
+	groups := core.AggregatePods(pods.Items, metricsMap, func(pod *v1.Pod) string {
+		return pod.Namespace
+	})
+
+	rows := make([]NamespaceSummaryRow, 0, len(groups))
+	for ns, agg := range groups {
+		rows = append(rows, NamespaceSummaryRow{
+			Namespace:        ns,
+			Pods:             agg.Pods,
+			CPUReq:           &agg.CPURequests,
+			CPULimit:         &agg.CPULimits,
+			CPUUsage:         &agg.CPUUsage,
+			MemReq:           &agg.MemoryRequests,
+			MemLimit:         &agg.MemoryLimits,
+			MemUsage:         &agg.MemoryUsage,
+			MetricsAvailable: metricsAvailable,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Namespace < rows[j].Namespace })
+
 	return rows, nil
 }
+
+// pendingPodFieldSelector selects pods the scheduler has not placed yet.
+const pendingPodFieldSelector = "spec.nodeName=,status.phase=Pending"
+
+// CollectPendingPods lists unscheduled pods for a given namespace and optional
+// selectors, joining each pod with its PodScheduled condition and the latest
+// FailedScheduling event. Event lookup failures are logged but not fatal.
+func CollectPendingPods(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	namespace string,
+	selector labels.Selector,
+) ([]PendingPodRow, error) {
+	listOptions := metav1.ListOptions{
+		ResourceVersion: "0",
+		FieldSelector:   pendingPodFieldSelector,
+	}
+	if selector != nil && !selector.Empty() {
+		listOptions.LabelSelector = selector.String()
+	}
+
+	pods, err := k8sClient.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return summarizePendingPods(ctx, k8sClient, pods.Items, namespace), nil
+}
+
+// summarizePendingPods builds one PendingPodRow per unscheduled pod in pods,
+// joining the latest FailedScheduling event of namespace (all namespaces
+// when empty). It is the part of CollectPendingPods shared with the live
+// TUI's informer cache.
+func summarizePendingPods(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	pods []v1.Pod,
+	namespace string,
+) []PendingPodRow {
+	// Events are only listed when there are pods to explain; the live
+	// Pending view refreshes every tick, even with --watch.
+	var eventsByPod map[string]*v1.Event
+	if len(pods) > 0 {
+		eventsByPod = latestFailedSchedulingEvents(ctx, k8sClient, namespace)
+	}
+
+	rows := make([]PendingPodRow, 0, len(pods))
+	for i := range pods {
+		pod := &pods[i]
+		// Field selectors are best-effort (and ignored by fake clients), so
+		// filter again locally.
+		if !core.IsUnscheduledPod(pod) {
+			continue
+		}
+
+		row := PendingPodRow{
+			Namespace:    pod.Namespace,
+			Name:         pod.Name,
+			Labels:       pod.Labels,
+			CPUReq:       resource.NewMilliQuantity(0, resource.DecimalSI),
+			MemReq:       resource.NewQuantity(0, resource.BinarySI),
+			GPUReq:       resource.NewQuantity(0, resource.DecimalSI),
+			CreationTime: pod.CreationTimestamp.Time,
+		}
+
+		for _, container := range pod.Spec.Containers {
+			if req := container.Resources.Requests.Cpu(); req != nil {
+				row.CPUReq.Add(*req)
+			}
+			if req := container.Resources.Requests.Memory(); req != nil {
+				row.MemReq.Add(*req)
+			}
+			for rName, qty := range container.Resources.Requests {
+				if core.IsGPUResource(rName) {
+					row.GPUReq.Add(qty)
+				}
+			}
+		}
+
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == v1.PodScheduled && cond.Status != v1.ConditionTrue {
+				row.ConditionReason = cond.Reason
+				row.ConditionMessage = cond.Message
+				break
+			}
+		}
+
+		if ev, ok := eventsByPod[pod.Namespace+"/"+pod.Name]; ok {
+			row.LastEventMessage = ev.Message
+			row.LastEventTime = eventTimestamp(ev)
+			row.FailedScheduling = ev.Count
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// latestFailedSchedulingEvents returns the most recent FailedScheduling event
+// for each pod in the namespace, keyed by namespace/name.
+func latestFailedSchedulingEvents(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	namespace string,
+) map[string]*v1.Event {
+	result := make(map[string]*v1.Event)
+
+	events, err := k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Pod,reason=FailedScheduling",
+	})
+	if err != nil {
+		log.Debugf("Failed to list FailedScheduling events for namespace %s: %v", namespace, err)
+		return result
+	}
+
+	for i := range events.Items {
+		ev := &events.Items[i]
+		if ev.Reason != "FailedScheduling" || ev.InvolvedObject.Kind != "Pod" {
+			continue
+		}
+		key := ev.InvolvedObject.Namespace + "/" + ev.InvolvedObject.Name
+		if existing, ok := result[key]; ok && !eventTimestamp(ev).After(eventTimestamp(existing)) {
+			continue
+		}
+		result[key] = ev
+	}
+
+	return result
+}
+
+// eventTimestamp returns the best available "last seen" time for an event,
+// accounting for the different fields populated by core/v1 and events/v1
+// producers.
+func eventTimestamp(ev *v1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	case !ev.FirstTimestamp.IsZero():
+		return ev.FirstTimestamp.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
@@ -15,15 +15,22 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	core "gitlab.com/davidxarnold/glance/pkg/core"
+	"gitlab.com/davidxarnold/glance/pkg/metricsource"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/fake"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 func TestCollectPodStats_GPUResources(t *testing.T) {
@@ -158,6 +165,401 @@ func TestCollectPodStats_MultiContainerGPU(t *testing.T) {
 
 func int32Ptr(i int32) *int32 { return &i }
 
+func TestCollectPodStats_ContainerBreakdown(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "app",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    *resource.NewMilliQuantity(500, resource.DecimalSI),
+							v1.ResourceMemory: *resource.NewQuantity(256*1024*1024, resource.BinarySI),
+						},
+						Limits: v1.ResourceList{
+							v1.ResourceMemory: *resource.NewQuantity(512*1024*1024, resource.BinarySI),
+						},
+					},
+				},
+				{
+					Name: "istio-proxy",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU: *resource.NewMilliQuantity(100, resource.DecimalSI),
+						},
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name:         "istio-proxy",
+					RestartCount: 3,
+					LastTerminationState: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled"},
+					},
+				},
+				{Name: "app"},
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset(pod)
+	rows, err := CollectPodStats(context.Background(), client, nil, "default", labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+
+	containers := rows[0].Containers
+	if len(containers) != 2 {
+		t.Fatalf("expected 2 containers, got %d", len(containers))
+	}
+
+	// Containers follow spec order, not status order.
+	app, proxy := containers[0], containers[1]
+	if app.Name != "app" || proxy.Name != "istio-proxy" {
+		t.Fatalf("unexpected container order: %q, %q", app.Name, proxy.Name)
+	}
+	if app.CPUReq.MilliValue() != 500 {
+		t.Errorf("expected app CPU request 500m, got %dm", app.CPUReq.MilliValue())
+	}
+	if app.MemLimit.Value() != 512*1024*1024 {
+		t.Errorf("expected app memory limit 512Mi, got %d", app.MemLimit.Value())
+	}
+	if app.RestartCount != 0 || app.LastTerminationReason != "" {
+		t.Errorf("expected no restarts for app, got %d (%q)", app.RestartCount, app.LastTerminationReason)
+	}
+	if proxy.CPUReq.MilliValue() != 100 {
+		t.Errorf("expected proxy CPU request 100m, got %dm", proxy.CPUReq.MilliValue())
+	}
+	if proxy.RestartCount != 3 {
+		t.Errorf("expected proxy restart count 3, got %d", proxy.RestartCount)
+	}
+	if proxy.LastTerminationReason != "OOMKilled" {
+		t.Errorf("expected proxy last termination OOMKilled, got %q", proxy.LastTerminationReason)
+	}
+	if proxy.CPUUsage == nil || !proxy.CPUUsage.IsZero() {
+		t.Errorf("expected zero proxy CPU usage without metrics, got %v", proxy.CPUUsage)
+	}
+}
+
+func TestCollectPodStats_TerminationSignals(t *testing.T) {
+	older := metav1.NewTime(time.Now().Add(-time.Hour))
+	newer := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+	memLimit := *resource.NewQuantity(256*1024*1024, resource.BinarySI)
+
+	oomPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec: v1.PodSpec{
+			NodeName: "node-1",
+			Containers: []v1.Container{
+				{Name: "app", Resources: v1.ResourceRequirements{
+					Limits: v1.ResourceList{v1.ResourceMemory: memLimit},
+				}},
+				{Name: "sidecar"},
+			},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "app", RestartCount: 3, LastTerminationState: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", FinishedAt: newer},
+				}},
+				{Name: "sidecar", RestartCount: 1, LastTerminationState: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{Reason: "Error", FinishedAt: older},
+				}},
+			},
+		},
+	}
+	evictedPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "batch", Namespace: "default"},
+		Spec:       v1.PodSpec{NodeName: "node-2", Containers: []v1.Container{{Name: "job"}}},
+		Status:     v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"},
+	}
+
+	client := fake.NewSimpleClientset(oomPod, evictedPod)
+	rows, err := CollectPodStats(context.Background(), client, nil, "default", labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byName := make(map[string]PodSummaryRow)
+	for _, r := range rows {
+		byName[r.Name] = r
+	}
+
+	api := byName["api"]
+	if api.Restarts != 4 {
+		t.Errorf("expected 4 restarts, got %d", api.Restarts)
+	}
+	if api.LastTerminationReason != "OOMKilled" || !api.LastTerminationTime.Equal(newer.Time) {
+		t.Errorf("expected most recent termination OOMKilled at %v, got %q at %v",
+			newer.Time, api.LastTerminationReason, api.LastTerminationTime)
+	}
+	if api.OOMKills != 1 {
+		t.Errorf("expected 1 OOM kill, got %d", api.OOMKills)
+	}
+
+	batch := byName["batch"]
+	if batch.Status != "Evicted" || batch.LastTerminationReason != "Evicted" {
+		t.Errorf("expected evicted pod status and reason, got %q / %q", batch.Status, batch.LastTerminationReason)
+	}
+
+	report := BuildOOMReport(rows)
+	if len(report) != 1 {
+		t.Fatalf("expected 1 OOM report row, got %d", len(report))
+	}
+	if report[0].Container != "app" || report[0].Node != "node-1" || report[0].RestartCount != 3 {
+		t.Errorf("unexpected OOM report row: %+v", report[0])
+	}
+	if report[0].MemLimit == nil || report[0].MemLimit.Value() != memLimit.Value() {
+		t.Errorf("expected memory limit %v, got %v", memLimit.String(), report[0].MemLimit)
+	}
+}
+
+func TestBuildOOMReport_SortsMostRecentFirst(t *testing.T) {
+	now := time.Now()
+	pods := []PodSummaryRow{
+		{Namespace: "a", Name: "old", Containers: []ContainerSummaryRow{
+			{Name: "c", LastTerminationReason: "OOMKilled", LastTerminationTime: now.Add(-time.Hour)},
+		}},
+		{Namespace: "b", Name: "recent", Containers: []ContainerSummaryRow{
+			{Name: "c", LastTerminationReason: "OOMKilled", LastTerminationTime: now.Add(-time.Minute)},
+			{Name: "d", LastTerminationReason: "Completed", LastTerminationTime: now},
+		}},
+	}
+
+	report := BuildOOMReport(pods)
+	if len(report) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(report))
+	}
+	if report[0].Pod != "recent" || report[1].Pod != "old" {
+		t.Errorf("expected most recent kill first, got %s then %s", report[0].Pod, report[1].Pod)
+	}
+}
+
+// stubMetricsSource is an in-memory metricsource.Source for tests. It
+// records the namespace of every PodMetrics call.
+type stubMetricsSource struct {
+	nodes map[string]*metricsv1beta1.NodeMetrics
+	pods  map[string]*metricsv1beta1.PodMetrics
+	err   error
+
+	mu         sync.Mutex
+	namespaces []string
+}
+
+func (s *stubMetricsSource) Name() string { return "stub" }
+
+func (s *stubMetricsSource) NodeMetrics(context.Context) (map[string]*metricsv1beta1.NodeMetrics, error) {
+	return s.nodes, s.err
+}
+
+func (s *stubMetricsSource) PodMetrics(_ context.Context, namespace string) (map[string]*metricsv1beta1.PodMetrics, error) {
+	s.mu.Lock()
+	s.namespaces = append(s.namespaces, namespace)
+	s.mu.Unlock()
+	if namespace == "" || s.pods == nil {
+		return s.pods, s.err
+	}
+	pods := make(map[string]*metricsv1beta1.PodMetrics)
+	for key, m := range s.pods {
+		if m.Namespace == namespace {
+			pods[key] = m
+		}
+	}
+	return pods, s.err
+}
+
+func TestCollectPodStats_MetricsSource(t *testing.T) {
+	// Two pods with the same name in different namespaces must not share usage.
+	podA := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "a"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+	}
+	podB := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "b"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+	}
+	source := &stubMetricsSource{pods: map[string]*metricsv1beta1.PodMetrics{
+		metricsource.PodKey("a", "web"): {
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "a"},
+			Containers: []metricsv1beta1.ContainerMetrics{{
+				Name: "app",
+				Usage: v1.ResourceList{
+					v1.ResourceCPU:    *resource.NewMilliQuantity(300, resource.DecimalSI),
+					v1.ResourceMemory: *resource.NewQuantity(64*1024*1024, resource.BinarySI),
+				},
+			}},
+		},
+	}}
+
+	client := fake.NewSimpleClientset(podA, podB)
+	rows, err := CollectPodStats(context.Background(), client, source, "", labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	usage := make(map[string]int64)
+	for _, r := range rows {
+		usage[r.Namespace] = r.CPUUsage.MilliValue()
+	}
+	if usage["a"] != 300 {
+		t.Errorf("expected 300m CPU usage for a/web, got %dm", usage["a"])
+	}
+	if usage["b"] != 0 {
+		t.Errorf("expected no CPU usage for b/web, got %dm", usage["b"])
+	}
+}
+
+func TestCollectNamespaceStats(t *testing.T) {
+	running := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+		Spec: v1.PodSpec{Containers: []v1.Container{{
+			Name: "app",
+			Resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{v1.ResourceCPU: *resource.NewMilliQuantity(500, resource.DecimalSI)},
+			},
+		}}},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	done := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "shop"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "job"}}},
+		Status:     v1.PodStatus{Phase: v1.PodSucceeded},
+	}
+	source := &stubMetricsSource{pods: map[string]*metricsv1beta1.PodMetrics{
+		metricsource.PodKey("shop", "web"): {
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+			Containers: []metricsv1beta1.ContainerMetrics{{
+				Name:  "app",
+				Usage: v1.ResourceList{v1.ResourceCPU: *resource.NewMilliQuantity(200, resource.DecimalSI)},
+			}},
+		},
+	}}
+
+	client := fake.NewSimpleClientset(running, done)
+	rows, err := CollectNamespaceStats(context.Background(), client, source, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 namespace, got %d", len(rows))
+	}
+	r := rows[0]
+	if r.Pods != 1 {
+		t.Errorf("expected completed pods to be skipped, got %d pods", r.Pods)
+	}
+	if !r.MetricsAvailable || r.CPUUsage.MilliValue() != 200 || r.CPULimit.MilliValue() != 500 {
+		t.Errorf("unexpected usage/limit: available=%v usage=%s limit=%s",
+			r.MetricsAvailable, r.CPUUsage.String(), r.CPULimit.String())
+	}
+
+	source.err = errors.New("metrics unavailable")
+	rows, err = CollectNamespaceStats(context.Background(), client, source, "shop")
+	if err != nil {
+		t.Fatalf("expected missing metrics to be non-fatal, got %v", err)
+	}
+	if rows[0].MetricsAvailable {
+		t.Error("expected MetricsAvailable=false when pod metrics fail")
+	}
+}
+
+func TestCollectPendingPods(t *testing.T) {
+	created := time.Now().Add(-10 * time.Minute)
+	pending := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "big-pod",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "app",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    *resource.NewMilliQuantity(4000, resource.DecimalSI),
+						v1.ResourceMemory: *resource.NewQuantity(8*1024*1024*1024, resource.BinarySI),
+					},
+				},
+			}},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodPending,
+			Conditions: []v1.PodCondition{{
+				Type:    v1.PodScheduled,
+				Status:  v1.ConditionFalse,
+				Reason:  "Unschedulable",
+				Message: "0/3 nodes are available: 3 Insufficient cpu.",
+			}},
+		},
+	}
+	// Bound to a node but still pulling images: not unscheduled.
+	bound := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pulling", Namespace: "default"},
+		Spec:       v1.PodSpec{NodeName: "node-1"},
+		Status:     v1.PodStatus{Phase: v1.PodPending},
+	}
+
+	olderEvent := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "big-pod.1", Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "big-pod", Namespace: "default"},
+		Reason:         "FailedScheduling",
+		Message:        "old message",
+		LastTimestamp:  metav1.NewTime(created.Add(time.Minute)),
+	}
+	latestEvent := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "big-pod.2", Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "big-pod", Namespace: "default"},
+		Reason:         "FailedScheduling",
+		Message:        "0/3 nodes are available: 3 Insufficient cpu.",
+		Count:          5,
+		LastTimestamp:  metav1.NewTime(created.Add(5 * time.Minute)),
+	}
+	otherEvent := &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "big-pod.3", Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "big-pod", Namespace: "default"},
+		Reason:         "Scheduled",
+		Message:        "should be ignored",
+		LastTimestamp:  metav1.NewTime(created.Add(9 * time.Minute)),
+	}
+
+	client := fake.NewSimpleClientset(pending, bound, olderEvent, latestEvent, otherEvent)
+	rows, err := CollectPendingPods(context.Background(), client, "default", labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 pending row, got %d", len(rows))
+	}
+
+	row := rows[0]
+	if row.Name != "big-pod" {
+		t.Errorf("expected pod big-pod, got %s", row.Name)
+	}
+	if row.CPUReq.MilliValue() != 4000 {
+		t.Errorf("expected CPU requests 4000m, got %dm", row.CPUReq.MilliValue())
+	}
+	if row.MemReq.Value() != 8*1024*1024*1024 {
+		t.Errorf("expected memory requests 8Gi, got %d", row.MemReq.Value())
+	}
+	if row.ConditionReason != "Unschedulable" {
+		t.Errorf("expected condition reason Unschedulable, got %q", row.ConditionReason)
+	}
+	if row.LastEventMessage != latestEvent.Message {
+		t.Errorf("expected latest event message, got %q", row.LastEventMessage)
+	}
+	if row.FailedScheduling != 5 {
+		t.Errorf("expected event count 5, got %d", row.FailedScheduling)
+	}
+}
+
 func TestCollectDeploymentStats_GPUResources(t *testing.T) {
 	deploy := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{Name: "gpu-deploy", Namespace: "default"},
@@ -256,3 +658,68 @@ func TestCollectDeploymentStats_NoGPU(t *testing.T) {
 		t.Errorf("expected GPU limits 0, got %d", row.GPULimit.Value())
 	}
 }
+
+func TestCollectPodStats_MetricsUnavailable(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "a"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+	}
+	client := fake.NewSimpleClientset(pod)
+
+	tests := []struct {
+		name   string
+		source metricsource.Source
+		want   bool
+	}{
+		{name: "metrics off", source: nil, want: false},
+		{name: "source error", source: &stubMetricsSource{err: errors.New("metrics.k8s.io not found")}, want: false},
+		{name: "source ok", source: &stubMetricsSource{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := CollectPodStats(context.Background(), client, tt.source, "", labels.Everything())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rows) != 1 || rows[0].MetricsAvailable != tt.want {
+				t.Fatalf("expected MetricsAvailable=%v, got %+v", tt.want, rows)
+			}
+
+			err = requirePodMetrics(metricsModeRequired, &stubMetricsSource{}, rows)
+			if (err != nil) == tt.want {
+				t.Errorf("requirePodMetrics error = %v with MetricsAvailable=%v", err, tt.want)
+			}
+			if err := requirePodMetrics(metricsModeAuto, nil, rows); err != nil {
+				t.Errorf("auto mode should never fail, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRowJSONOmitsZeroTimes(t *testing.T) {
+	rows := []struct {
+		row   any
+		field string
+	}{
+		{PendingPodRow{Name: "queued"}, "LastEventTime"},
+		{PodSummaryRow{Name: "web"}, "LastTerminationTime"},
+		{ContainerSummaryRow{Name: "app"}, "LastTerminationTime"},
+	}
+	for _, r := range rows {
+		b, err := json.Marshal(r.row)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(b), r.field) {
+			t.Errorf("expected a zero %s to be omitted, got %s", r.field, b)
+		}
+	}
+
+	b, err := json.Marshal(PendingPodRow{LastEventTime: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"LastEventTime":"2025-01-02T03:04:05Z"`) {
+		t.Errorf("expected LastEventTime in %s", b)
+	}
+}
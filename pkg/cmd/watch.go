@@ -15,12 +15,18 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -36,11 +42,17 @@ type WatchCache struct {
 	namespaces []v1.Namespace
 
 	// Informer factory
-	factory informers.SharedInformerFactory
-	stopCh  chan struct{}
+	factory  informers.SharedInformerFactory
+	stopCh   chan struct{}
+	stopOnce sync.Once
 
-	// Change notification
+	// The deployment informer is only started once deployments are read.
+	deploymentsOnce sync.Once
+
+	// Change notification; stale marks the cached copies for rebuild on
+	// the next read.
 	updateCh chan struct{}
+	stale    bool
 
 	// Stats
 	lastUpdate time.Time
@@ -49,14 +61,17 @@ type WatchCache struct {
 }
 
 // NewWatchCache creates a new informer-based cache for cluster data.
-func NewWatchCache(k8sClient *kubernetes.Clientset, resyncPeriod time.Duration) *WatchCache {
+func NewWatchCache(k8sClient kubernetes.Interface, resyncPeriod time.Duration) *WatchCache {
 	wc := &WatchCache{
 		stopCh:   make(chan struct{}),
 		updateCh: make(chan struct{}, 1), // Buffered to avoid blocking
 	}
 
-	// Create shared informer factory with resync period
-	wc.factory = informers.NewSharedInformerFactory(k8sClient, resyncPeriod)
+	// Create shared informer factory with resync period. managedFields are
+	// never read and can be a large share of each object, so they are
+	// dropped before objects are stored.
+	wc.factory = informers.NewSharedInformerFactoryWithOptions(k8sClient, resyncPeriod,
+		informers.WithTransform(stripManagedFields))
 
 	// Set up node informer
 	nodeInformer := wc.factory.Core().V1().Nodes().Informer()
@@ -85,19 +100,40 @@ func NewWatchCache(k8sClient *kubernetes.Clientset, resyncPeriod time.Duration)
 	return wc
 }
 
-// Start begins the informer sync process.
+// Start starts the informers and waits for their initial sync. It returns
+// an error, leaving the informers running until Stop, when ctx is done or
+// Stop is called before every informer has synced; an informer whose list
+// is denied (for example by RBAC) retries until then rather than failing.
 func (wc *WatchCache) Start(ctx context.Context) error {
 	// Start the informer factory
 	wc.factory.Start(wc.stopCh)
 
+	// Stop waiting when ctx is done or the cache is stopped.
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-wc.stopCh:
+			cancel()
+		case <-syncCtx.Done():
+		}
+	}()
+
 	// Wait for initial cache sync
 	log.Debug("Waiting for informer caches to sync...")
-	synced := wc.factory.WaitForCacheSync(wc.stopCh)
-	for informerType, ok := range synced {
+	var failed []string
+	for informerType, ok := range wc.factory.WaitForCacheSync(syncCtx.Done()) {
 		if !ok {
-			log.Warnf("Failed to sync cache for: %v", informerType)
+			failed = append(failed, informerType.Elem().Name())
 		}
 	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("%s informer caches did not sync: %w", strings.Join(failed, ", "), err)
+		}
+		return fmt.Errorf("%s informer caches did not sync", strings.Join(failed, ", "))
+	}
 	log.Debug("Informer caches synced")
 
 	// Initial data load
@@ -106,9 +142,9 @@ func (wc *WatchCache) Start(ctx context.Context) error {
 	return nil
 }
 
-// Stop stops the informers.
+// Stop stops the informers. It is safe to call more than once.
 func (wc *WatchCache) Stop() {
-	close(wc.stopCh)
+	wc.stopOnce.Do(func() { close(wc.stopCh) })
 }
 
 // Updates returns a channel that receives notifications when data changes.
@@ -116,9 +152,14 @@ func (wc *WatchCache) Updates() <-chan struct{} {
 	return wc.updateCh
 }
 
-// notifyUpdate sends a non-blocking notification of data change.
+// notifyUpdate marks the cached data stale and sends a non-blocking
+// notification of data change. The data is rebuilt on the next read, so a
+// burst of events (such as the initial sync of a large cluster) costs one
+// rebuild rather than one per event.
 func (wc *WatchCache) notifyUpdate() {
-	wc.refreshData()
+	wc.mu.Lock()
+	wc.stale = true
+	wc.mu.Unlock()
 	select {
 	case wc.updateCh <- struct{}{}:
 	default:
@@ -132,7 +173,7 @@ func (wc *WatchCache) refreshData() {
 	defer wc.mu.Unlock()
 
 	// Get nodes from cache
-	nodeList, err := wc.factory.Core().V1().Nodes().Lister().List(nil)
+	nodeList, err := wc.factory.Core().V1().Nodes().Lister().List(labels.Everything())
 	if err != nil {
 		log.Debugf("Failed to list nodes from cache: %v", err)
 		return
@@ -144,7 +185,7 @@ func (wc *WatchCache) refreshData() {
 	wc.nodeCount = len(wc.nodes)
 
 	// Get pods from cache
-	podList, err := wc.factory.Core().V1().Pods().Lister().List(nil)
+	podList, err := wc.factory.Core().V1().Pods().Lister().List(labels.Everything())
 	if err != nil {
 		log.Debugf("Failed to list pods from cache: %v", err)
 		return
@@ -156,7 +197,7 @@ func (wc *WatchCache) refreshData() {
 	wc.podCount = len(wc.pods)
 
 	// Get namespaces from cache
-	nsList, err := wc.factory.Core().V1().Namespaces().Lister().List(nil)
+	nsList, err := wc.factory.Core().V1().Namespaces().Lister().List(labels.Everything())
 	if err != nil {
 		log.Debugf("Failed to list namespaces from cache: %v", err)
 		return
@@ -167,10 +208,32 @@ func (wc *WatchCache) refreshData() {
 	}
 
 	wc.lastUpdate = time.Now()
+	wc.stale = false
+}
+
+// refreshIfStale rebuilds the cached data if informers reported a change
+// since the last rebuild.
+func (wc *WatchCache) refreshIfStale() {
+	wc.mu.RLock()
+	stale := wc.stale
+	wc.mu.RUnlock()
+	if stale {
+		wc.refreshData()
+	}
+}
+
+// stripManagedFields is an informer transform that drops managedFields from
+// stored objects.
+func stripManagedFields(obj interface{}) (interface{}, error) {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+	return obj, nil
 }
 
 // GetNodes returns a copy of cached nodes.
 func (wc *WatchCache) GetNodes() []v1.Node {
+	wc.refreshIfStale()
 	wc.mu.RLock()
 	defer wc.mu.RUnlock()
 	result := make([]v1.Node, len(wc.nodes))
@@ -180,6 +243,7 @@ func (wc *WatchCache) GetNodes() []v1.Node {
 
 // GetPods returns a copy of cached pods.
 func (wc *WatchCache) GetPods() []v1.Pod {
+	wc.refreshIfStale()
 	wc.mu.RLock()
 	defer wc.mu.RUnlock()
 	result := make([]v1.Pod, len(wc.pods))
@@ -189,6 +253,7 @@ func (wc *WatchCache) GetPods() []v1.Pod {
 
 // GetPodsByNode returns pods grouped by node name.
 func (wc *WatchCache) GetPodsByNode() map[string][]v1.Pod {
+	wc.refreshIfStale()
 	wc.mu.RLock()
 	defer wc.mu.RUnlock()
 
@@ -203,6 +268,7 @@ func (wc *WatchCache) GetPodsByNode() map[string][]v1.Pod {
 
 // GetPodsByNamespace returns pods grouped by namespace.
 func (wc *WatchCache) GetPodsByNamespace() map[string][]v1.Pod {
+	wc.refreshIfStale()
 	wc.mu.RLock()
 	defer wc.mu.RUnlock()
 
@@ -215,6 +281,7 @@ func (wc *WatchCache) GetPodsByNamespace() map[string][]v1.Pod {
 
 // GetNamespaces returns a copy of cached namespaces.
 func (wc *WatchCache) GetNamespaces() []v1.Namespace {
+	wc.refreshIfStale()
 	wc.mu.RLock()
 	defer wc.mu.RUnlock()
 	result := make([]v1.Namespace, len(wc.namespaces))
@@ -222,8 +289,52 @@ func (wc *WatchCache) GetNamespaces() []v1.Namespace {
 	return result
 }
 
+// GetPod returns a pod by namespace and name.
+func (wc *WatchCache) GetPod(namespace, name string) (*v1.Pod, bool) {
+	pod, err := wc.factory.Core().V1().Pods().Lister().Pods(namespace).Get(name)
+	if err != nil {
+		return nil, false
+	}
+	return pod.DeepCopy(), true
+}
+
+// GetDeployments returns a copy of the cached deployments of namespace (all
+// namespaces when empty). The deployment informer is started by the first
+// call, and ok is false until it has synced.
+func (wc *WatchCache) GetDeployments(namespace string) (deployments []appsv1.Deployment, ok bool) {
+	informer := wc.factory.Apps().V1().Deployments()
+	wc.deploymentsOnce.Do(func() {
+		_, _ = informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(_ interface{}) { wc.notifyUpdate() },
+			UpdateFunc: func(_, _ interface{}) { wc.notifyUpdate() },
+			DeleteFunc: func(_ interface{}) { wc.notifyUpdate() },
+		})
+		wc.factory.Start(wc.stopCh)
+	})
+	if !informer.Informer().HasSynced() {
+		return nil, false
+	}
+
+	var list []*appsv1.Deployment
+	var err error
+	if namespace == "" {
+		list, err = informer.Lister().List(labels.Everything())
+	} else {
+		list, err = informer.Lister().Deployments(namespace).List(labels.Everything())
+	}
+	if err != nil {
+		return nil, false
+	}
+	deployments = make([]appsv1.Deployment, 0, len(list))
+	for _, d := range list {
+		deployments = append(deployments, *d)
+	}
+	return deployments, true
+}
+
 // GetStats returns cache statistics.
 func (wc *WatchCache) GetStats() (nodeCount, podCount int, lastUpdate time.Time) {
+	wc.refreshIfStale()
 	wc.mu.RLock()
 	defer wc.mu.RUnlock()
 	return wc.nodeCount, wc.podCount, wc.lastUpdate
@@ -231,6 +342,7 @@ func (wc *WatchCache) GetStats() (nodeCount, podCount int, lastUpdate time.Time)
 
 // GetNodeByName returns a specific node by name.
 func (wc *WatchCache) GetNodeByName(name string) (*v1.Node, bool) {
+	wc.refreshIfStale()
 	wc.mu.RLock()
 	defer wc.mu.RUnlock()
 
@@ -249,6 +361,7 @@ func (wc *WatchCache) ListNodesWithSelector(selector string) ([]v1.Node, error)
 		return wc.GetNodes(), nil
 	}
 
+	wc.refreshIfStale()
 	wc.mu.RLock()
 	defer wc.mu.RUnlock()
 
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metricsV1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// Workload kinds reported by WorkloadOf.
+const (
+	WorkloadDeployment = "Deployment"
+	WorkloadPod        = "Pod"
+)
+
+// ResourceAggregate sums requests, limits and usage over a group of pods,
+// such as a namespace or a workload.
+type ResourceAggregate struct {
+	Pods           int
+	CPURequests    resource.Quantity
+	CPULimits      resource.Quantity
+	CPUUsage       resource.Quantity
+	MemoryRequests resource.Quantity
+	MemoryLimits   resource.Quantity
+	MemoryUsage    resource.Quantity
+}
+
+// newResourceAggregate returns an aggregate with zero quantities in the
+// formats used by the node totals.
+func newResourceAggregate() *ResourceAggregate {
+	return &ResourceAggregate{
+		CPURequests:    *resource.NewMilliQuantity(0, resource.DecimalSI),
+		CPULimits:      *resource.NewMilliQuantity(0, resource.DecimalSI),
+		CPUUsage:       *resource.NewMilliQuantity(0, resource.DecimalSI),
+		MemoryRequests: *resource.NewQuantity(0, resource.BinarySI),
+		MemoryLimits:   *resource.NewQuantity(0, resource.BinarySI),
+		MemoryUsage:    *resource.NewQuantity(0, resource.BinarySI),
+	}
+}
+
+// AggregatePods groups non-terminated pods by groupKey and sums their
+// container requests, limits and usage. podMetrics is keyed by
+// "namespace/name" (as returned by metricsource.Source.PodMetrics) and may be
+// nil when usage is unknown. Pods for which groupKey returns "" are skipped.
+func AggregatePods(
+	pods []v1.Pod,
+	podMetrics map[string]*metricsV1beta1api.PodMetrics,
+	groupKey func(pod *v1.Pod) string,
+) map[string]*ResourceAggregate {
+	groups := make(map[string]*ResourceAggregate)
+
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		key := groupKey(pod)
+		if key == "" {
+			continue
+		}
+		agg, ok := groups[key]
+		if !ok {
+			agg = newResourceAggregate()
+			groups[key] = agg
+		}
+
+		agg.Pods++
+		for _, container := range pod.Spec.Containers {
+			if req := container.Resources.Requests.Cpu(); req != nil {
+				agg.CPURequests.Add(*req)
+			}
+			if lim := container.Resources.Limits.Cpu(); lim != nil {
+				agg.CPULimits.Add(*lim)
+			}
+			if req := container.Resources.Requests.Memory(); req != nil {
+				agg.MemoryRequests.Add(*req)
+			}
+			if lim := container.Resources.Limits.Memory(); lim != nil {
+				agg.MemoryLimits.Add(*lim)
+			}
+		}
+
+		if pm := podMetrics[pod.Namespace+"/"+pod.Name]; pm != nil {
+			for _, c := range pm.Containers {
+				agg.CPUUsage.Add(c.Usage[v1.ResourceCPU])
+				agg.MemoryUsage.Add(c.Usage[v1.ResourceMemory])
+			}
+		}
+	}
+
+	return groups
+}
+
+// WorkloadOf returns the kind and name of the workload that owns pod. Pods
+// created by a ReplicaSet are attributed to their Deployment using the
+// pod-template-hash label; other controllers (StatefulSet, DaemonSet, Job,
+// ...) are reported as-is, and unowned pods as kind "Pod".
+func WorkloadOf(pod *v1.Pod) (kind, name string) {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Controller == nil || !*ref.Controller {
+			continue
+		}
+		if ref.Kind == "ReplicaSet" {
+			if hash := pod.Labels["pod-template-hash"]; hash != "" && strings.HasSuffix(ref.Name, "-"+hash) {
+				return WorkloadDeployment, strings.TrimSuffix(ref.Name, "-"+hash)
+			}
+		}
+		return ref.Kind, ref.Name
+	}
+	return WorkloadPod, pod.Name
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsV1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestAggregatePods(t *testing.T) {
+	requests := v1.ResourceRequirements{
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    *resource.NewMilliQuantity(250, resource.DecimalSI),
+			v1.ResourceMemory: *resource.NewQuantity(256*1024*1024, resource.BinarySI),
+		},
+		Limits: v1.ResourceList{
+			v1.ResourceCPU: *resource.NewMilliQuantity(500, resource.DecimalSI),
+		},
+	}
+	pod := func(ns, name string, phase v1.PodPhase) v1.Pod {
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Resources: requests}}},
+			Status:     v1.PodStatus{Phase: phase},
+		}
+	}
+	pods := []v1.Pod{
+		pod("payments", "api-0", v1.PodRunning),
+		pod("payments", "api-1", v1.PodPending),
+		pod("payments", "done", v1.PodSucceeded), // terminated: ignored
+		pod("kube-system", "dns", v1.PodRunning),
+	}
+	metrics := map[string]*metricsV1beta1api.PodMetrics{
+		"payments/api-0": {Containers: []metricsV1beta1api.ContainerMetrics{{
+			Usage: v1.ResourceList{v1.ResourceCPU: *resource.NewMilliQuantity(100, resource.DecimalSI)},
+		}}},
+	}
+
+	groups := AggregatePods(pods, metrics, func(p *v1.Pod) string { return p.Namespace })
+
+	payments := groups["payments"]
+	if payments == nil || payments.Pods != 2 {
+		t.Fatalf("expected 2 non-terminated payments pods, got %+v", payments)
+	}
+	if got := payments.CPURequests.MilliValue(); got != 500 {
+		t.Errorf("expected 500m CPU requests, got %dm", got)
+	}
+	if got := payments.CPULimits.MilliValue(); got != 1000 {
+		t.Errorf("expected 1000m CPU limits, got %dm", got)
+	}
+	if got := payments.MemoryRequests.Value(); got != 512*1024*1024 {
+		t.Errorf("expected 512Mi memory requests, got %d", got)
+	}
+	if got := payments.CPUUsage.MilliValue(); got != 100 {
+		t.Errorf("expected 100m CPU usage, got %dm", got)
+	}
+	if ks := groups["kube-system"]; ks == nil || ks.Pods != 1 || !ks.CPUUsage.IsZero() {
+		t.Errorf("unexpected kube-system aggregate: %+v", ks)
+	}
+}
+
+func TestWorkloadOf(t *testing.T) {
+	controller := true
+	tests := []struct {
+		name     string
+		pod      v1.Pod
+		wantKind string
+		wantName string
+	}{
+		{
+			name: "deployment via replicaset",
+			pod: v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "api-7d9f8-abcde",
+				Labels:          map[string]string{"pod-template-hash": "7d9f8"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "api-7d9f8", Controller: &controller}},
+			}},
+			wantKind: WorkloadDeployment,
+			wantName: "api",
+		},
+		{
+			name: "statefulset",
+			pod: v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:            "db-0",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "db", Controller: &controller}},
+			}},
+			wantKind: "StatefulSet",
+			wantName: "db",
+		},
+		{
+			name:     "bare pod",
+			pod:      v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "debug"}},
+			wantKind: WorkloadPod,
+			wantName: "debug",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, name := WorkloadOf(&tt.pod)
+			if kind != tt.wantKind || name != tt.wantName {
+				t.Errorf("WorkloadOf() = %s/%s, want %s/%s", kind, name, tt.wantKind, tt.wantName)
+			}
+		})
+	}
+}
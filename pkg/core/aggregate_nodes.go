@@ -132,9 +132,11 @@ func populateNodeResources(stats *NodeStats, node *v1.Node) {
 	}
 }
 
-// aggregatePodResources sums CPU, memory, and GPU requests/limits from all pods into stats.
+// aggregatePodResources sums CPU, memory, and GPU requests/limits from all
+// pods into stats and counts containers whose last termination was an OOM kill.
 func aggregatePodResources(stats *NodeStats, pods []v1.Pod) {
 	stats.PodCount = len(pods)
+	stats.OOMKills = 0
 
 	cpuReq := resource.NewMilliQuantity(0, resource.DecimalSI)
 	cpuLim := resource.NewMilliQuantity(0, resource.DecimalSI)
@@ -143,7 +145,8 @@ func aggregatePodResources(stats *NodeStats, pods []v1.Pod) {
 	gpuReq := resource.NewQuantity(0, resource.DecimalSI)
 	gpuLim := resource.NewQuantity(0, resource.DecimalSI)
 
-	for _, pod := range pods {
+	for i, pod := range pods {
+		stats.OOMKills += CountOOMKills(&pods[i])
 		for _, container := range pod.Spec.Containers {
 			if req := container.Resources.Requests.Cpu(); req != nil {
 				cpuReq.Add(*req)
@@ -221,6 +224,7 @@ func accumulateTotals(totals *Totals, stats *NodeStats) {
 	}
 	totals.TotalAllocatedGPURequests.Add(stats.AllocatedGPURequests)
 	totals.TotalAllocatedGPULimits.Add(stats.AllocatedGPULimits)
+	totals.TotalOOMKills += stats.OOMKills
 
 	if stats.UsageCPU != nil {
 		totals.TotalUsageCPU.Add(*stats.UsageCPU)
@@ -304,3 +304,37 @@ func TestIsGPUResource(t *testing.T) {
 		}
 	}
 }
+
+func TestComputeNodeSnapshot_OOMKills(t *testing.T) {
+	node := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+		},
+	}
+	oomPod := v1.Pod{
+		Spec: v1.PodSpec{NodeName: "node-1"},
+		Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{{
+			LastTerminationState: v1.ContainerState{
+				Terminated: &v1.ContainerStateTerminated{Reason: ReasonOOMKilled},
+			},
+		}}},
+	}
+	healthyPod := v1.Pod{Spec: v1.PodSpec{NodeName: "node-1"}}
+
+	nm, totals, err := ComputeNodeSnapshot(
+		[]v1.Node{node},
+		map[string][]v1.Pod{"node-1": {oomPod, healthyPod}},
+		nil,
+		NodeSnapshotOptions{},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := nm["node-1"].OOMKills; got != 1 {
+		t.Errorf("expected 1 OOM kill on node-1, got %d", got)
+	}
+	if totals.TotalOOMKills != 1 {
+		t.Errorf("expected 1 total OOM kill, got %d", totals.TotalOOMKills)
+	}
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	_ "embed" // for SnapshotSchema
+	"math"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// The glance/v1 snapshot document is the stable JSON output of the node
+// view. Unlike Snapshot, whose shape follows the Go types, its field names
+// and meaning are fixed for glance/v1 and described by SnapshotSchema:
+// fields may be added, but are never renamed, removed or changed in type.
+// Incompatible changes require a new apiVersion.
+const (
+	DocumentAPIVersion = "glance/v1"
+	KindSnapshot       = "Snapshot"
+	KindSnapshotList   = "SnapshotList"
+)
+
+// SnapshotSchema is the JSON Schema (draft 2020-12) of the glance/v1
+// Snapshot and SnapshotList documents.
+//
+//go:embed schema/snapshot-v1.json
+var SnapshotSchema []byte
+
+// SnapshotDocument is a versioned snapshot of one cluster.
+type SnapshotDocument struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       string           `json:"kind"`
+	Metadata   DocumentMetadata `json:"metadata"`
+	Nodes      []NodeDocument   `json:"nodes"`
+	Totals     TotalsDocument   `json:"totals"`
+}
+
+// SnapshotListDocument holds one SnapshotDocument per cluster of a fleet.
+type SnapshotListDocument struct {
+	APIVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Items      []SnapshotDocument `json:"items"`
+}
+
+// DocumentMetadata describes when and where a snapshot was taken.
+type DocumentMetadata struct {
+	GeneratedAt   time.Time `json:"generatedAt"`
+	Context       string    `json:"context,omitempty"`
+	Cluster       string    `json:"cluster,omitempty"`
+	Server        string    `json:"server,omitempty"`
+	ServerVersion string    `json:"serverVersion,omitempty"`
+	// MetricsAvailable is false when usage could not be read; usage fields
+	// are then null.
+	MetricsAvailable bool `json:"metricsAvailable"`
+	// Error is set, and the snapshot left empty, when the cluster could not
+	// be read (fleet view only).
+	Error string `json:"error,omitempty"`
+}
+
+// CPUQuantity is a CPU amount as the Kubernetes quantity string and in cores.
+type CPUQuantity struct {
+	Value string  `json:"value"`
+	Cores float64 `json:"cores"`
+}
+
+// ByteQuantity is a memory amount as the Kubernetes quantity string and in
+// bytes.
+type ByteQuantity struct {
+	Value string `json:"value"`
+	Bytes int64  `json:"bytes"`
+}
+
+// CountQuantity is a device amount, such as GPUs, as the Kubernetes quantity
+// string and as a count.
+type CountQuantity struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// CPUFigures are the CPU figures of a node or of the cluster. Percentages
+// are relative to allocatable; usage fields are null when unknown.
+type CPUFigures struct {
+	Capacity        *CPUQuantity `json:"capacity"`
+	Allocatable     *CPUQuantity `json:"allocatable"`
+	Requests        *CPUQuantity `json:"requests"`
+	Limits          *CPUQuantity `json:"limits"`
+	Usage           *CPUQuantity `json:"usage"`
+	RequestsPercent float64      `json:"requestsPercent"`
+	LimitsPercent   float64      `json:"limitsPercent"`
+	UsagePercent    *float64     `json:"usagePercent"`
+}
+
+// MemoryFigures are the memory figures of a node or of the cluster.
+// Percentages are relative to allocatable; usage fields are null when
+// unknown.
+type MemoryFigures struct {
+	Capacity        *ByteQuantity `json:"capacity"`
+	Allocatable     *ByteQuantity `json:"allocatable"`
+	Requests        *ByteQuantity `json:"requests"`
+	Limits          *ByteQuantity `json:"limits"`
+	Usage           *ByteQuantity `json:"usage"`
+	RequestsPercent float64       `json:"requestsPercent"`
+	LimitsPercent   float64       `json:"limitsPercent"`
+	UsagePercent    *float64      `json:"usagePercent"`
+}
+
+// GPUFigures are the GPU figures of a node or of the cluster. They are
+// omitted when no GPUs are allocatable.
+type GPUFigures struct {
+	Capacity        *CountQuantity `json:"capacity"`
+	Allocatable     *CountQuantity `json:"allocatable"`
+	Requests        *CountQuantity `json:"requests"`
+	Limits          *CountQuantity `json:"limits"`
+	RequestsPercent float64        `json:"requestsPercent"`
+}
+
+// NodeDocument is one node of a SnapshotDocument.
+type NodeDocument struct {
+	Name           string        `json:"name"`
+	Status         string        `json:"status"`
+	KubeletVersion string        `json:"kubeletVersion,omitempty"`
+	ProviderID     string        `json:"providerID,omitempty"`
+	Region         string        `json:"region,omitempty"`
+	InstanceType   string        `json:"instanceType,omitempty"`
+	NodeGroup      string        `json:"nodeGroup,omitempty"`
+	NodePool       string        `json:"nodePool,omitempty"`
+	CapacityType   string        `json:"capacityType,omitempty"`
+	CreatedAt      *time.Time    `json:"createdAt,omitempty"`
+	Pods           int           `json:"pods"`
+	OOMKills       int           `json:"oomKills"`
+	CPU            CPUFigures    `json:"cpu"`
+	Memory         MemoryFigures `json:"memory"`
+	GPU            *GPUFigures   `json:"gpu,omitempty"`
+}
+
+// TotalsDocument sums the nodes of a SnapshotDocument.
+type TotalsDocument struct {
+	Nodes    int             `json:"nodes"`
+	Pods     int             `json:"pods"`
+	OOMKills int             `json:"oomKills"`
+	CPU      CPUFigures      `json:"cpu"`
+	Memory   MemoryFigures   `json:"memory"`
+	GPU      *GPUFigures     `json:"gpu,omitempty"`
+	Pending  PendingDocument `json:"pending"`
+}
+
+// PendingDocument is the demand of pods the scheduler has not placed.
+type PendingDocument struct {
+	Pods           int           `json:"pods"`
+	CPURequests    *CPUQuantity  `json:"cpuRequests"`
+	MemoryRequests *ByteQuantity `json:"memoryRequests"`
+}
+
+// NewSnapshotDocument converts a snapshot to a glance/v1 document. Nodes are
+// sorted by name. meta.MetricsAvailable is taken from the snapshot totals.
+func NewSnapshotDocument(s Snapshot, meta DocumentMetadata) SnapshotDocument {
+	meta.MetricsAvailable = s.Totals.MetricsAvailable
+	if meta.Server == "" {
+		meta.Server = s.Totals.ClusterInfo.Host
+	}
+	if meta.ServerVersion == "" {
+		meta.ServerVersion = s.Totals.ClusterInfo.MasterVersion
+	}
+
+	names := make([]string, 0, len(s.Nodes))
+	for name := range s.Nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	doc := SnapshotDocument{
+		APIVersion: DocumentAPIVersion,
+		Kind:       KindSnapshot,
+		Metadata:   meta,
+		Nodes:      make([]NodeDocument, 0, len(names)),
+	}
+
+	for _, name := range names {
+		n := s.Nodes[name]
+		node := NodeDocument{
+			Name:           name,
+			Status:         n.Status,
+			KubeletVersion: n.NodeInfo.KubeletVersion,
+			ProviderID:     n.ProviderID,
+			Region:         n.Region,
+			InstanceType:   n.InstanceType,
+			NodeGroup:      n.NodeGroup,
+			NodePool:       n.NodePool,
+			CapacityType:   n.CapacityType,
+			Pods:           n.PodCount,
+			OOMKills:       n.OOMKills,
+			CPU: newCPUFigures(n.CapacityCPU, n.AllocatableCPU,
+				&n.AllocatedCPUrequests, &n.AllocatedCPULimits, n.UsageCPU),
+			Memory: newMemoryFigures(n.CapacityMemory, n.AllocatableMemory,
+				&n.AllocatedMemoryRequests, &n.AllocatedMemoryLimits, n.UsageMemory),
+			GPU: newGPUFigures(n.CapacityGPU, n.AllocatableGPU, &n.AllocatedGPURequests, &n.AllocatedGPULimits),
+		}
+		if !n.CreationTime.IsZero() {
+			created := n.CreationTime
+			node.CreatedAt = &created
+		}
+		doc.Nodes = append(doc.Nodes, node)
+		doc.Totals.Pods += n.PodCount
+	}
+
+	t := s.Totals
+	doc.Totals.Nodes = len(names)
+	doc.Totals.OOMKills = t.TotalOOMKills
+	cpuUsage, memUsage := t.TotalUsageCPU, t.TotalUsageMemory
+	if !t.MetricsAvailable {
+		cpuUsage, memUsage = nil, nil
+	}
+	doc.Totals.CPU = newCPUFigures(t.TotalCapacityCPU, t.TotalAllocatableCPU,
+		t.TotalAllocatedCPUrequests, t.TotalAllocatedCPULimits, cpuUsage)
+	doc.Totals.Memory = newMemoryFigures(t.TotalCapacityMemory, t.TotalAllocatableMemory,
+		t.TotalAllocatedMemoryRequests, t.TotalAllocatedMemoryLimits, memUsage)
+	doc.Totals.GPU = newGPUFigures(t.TotalCapacityGPU, t.TotalAllocatableGPU,
+		t.TotalAllocatedGPURequests, t.TotalAllocatedGPULimits)
+	doc.Totals.Pending = PendingDocument{
+		Pods:           t.PendingPods,
+		CPURequests:    newCPUQuantity(t.TotalPendingCPURequests),
+		MemoryRequests: newByteQuantity(t.TotalPendingMemoryRequests),
+	}
+	if doc.Totals.Pending.CPURequests == nil {
+		doc.Totals.Pending.CPURequests = &CPUQuantity{Value: "0"}
+	}
+	if doc.Totals.Pending.MemoryRequests == nil {
+		doc.Totals.Pending.MemoryRequests = &ByteQuantity{Value: "0"}
+	}
+
+	return doc
+}
+
+// NewSnapshotListDocument wraps per-cluster documents in a SnapshotList.
+func NewSnapshotListDocument(items []SnapshotDocument) SnapshotListDocument {
+	if items == nil {
+		items = []SnapshotDocument{}
+	}
+	return SnapshotListDocument{APIVersion: DocumentAPIVersion, Kind: KindSnapshotList, Items: items}
+}
+
+func newCPUQuantity(q *resource.Quantity) *CPUQuantity {
+	if q == nil {
+		return nil
+	}
+	return &CPUQuantity{Value: q.String(), Cores: float64(q.MilliValue()) / 1000}
+}
+
+func newByteQuantity(q *resource.Quantity) *ByteQuantity {
+	if q == nil {
+		return nil
+	}
+	return &ByteQuantity{Value: q.String(), Bytes: q.Value()}
+}
+
+func newCountQuantity(q *resource.Quantity) *CountQuantity {
+	if q == nil {
+		return nil
+	}
+	return &CountQuantity{Value: q.String(), Count: q.Value()}
+}
+
+// documentPercent returns part as a percentage of whole, rounded to two
+// decimals, or 0 when whole is zero.
+func documentPercent(part, whole float64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(part/whole*10000) / 100
+}
+
+func newCPUFigures(capacity, allocatable, requests, limits, usage *resource.Quantity) CPUFigures {
+	f := CPUFigures{
+		Capacity:    newCPUQuantity(capacity),
+		Allocatable: newCPUQuantity(allocatable),
+		Requests:    newCPUQuantity(requests),
+		Limits:      newCPUQuantity(limits),
+		Usage:       newCPUQuantity(usage),
+	}
+	if f.Allocatable == nil {
+		return f
+	}
+	alloc := f.Allocatable.Cores
+	if f.Requests != nil {
+		f.RequestsPercent = documentPercent(f.Requests.Cores, alloc)
+	}
+	if f.Limits != nil {
+		f.LimitsPercent = documentPercent(f.Limits.Cores, alloc)
+	}
+	if f.Usage != nil {
+		pct := documentPercent(f.Usage.Cores, alloc)
+		f.UsagePercent = &pct
+	}
+	return f
+}
+
+func newMemoryFigures(capacity, allocatable, requests, limits, usage *resource.Quantity) MemoryFigures {
+	f := MemoryFigures{
+		Capacity:    newByteQuantity(capacity),
+		Allocatable: newByteQuantity(allocatable),
+		Requests:    newByteQuantity(requests),
+		Limits:      newByteQuantity(limits),
+		Usage:       newByteQuantity(usage),
+	}
+	if f.Allocatable == nil {
+		return f
+	}
+	alloc := float64(f.Allocatable.Bytes)
+	if f.Requests != nil {
+		f.RequestsPercent = documentPercent(float64(f.Requests.Bytes), alloc)
+	}
+	if f.Limits != nil {
+		f.LimitsPercent = documentPercent(float64(f.Limits.Bytes), alloc)
+	}
+	if f.Usage != nil {
+		pct := documentPercent(float64(f.Usage.Bytes), alloc)
+		f.UsagePercent = &pct
+	}
+	return f
+}
+
+// newGPUFigures returns nil when no GPUs are allocatable.
+func newGPUFigures(capacity, allocatable, requests, limits *resource.Quantity) *GPUFigures {
+	if allocatable == nil || allocatable.IsZero() {
+		return nil
+	}
+	f := &GPUFigures{
+		Capacity:    newCountQuantity(capacity),
+		Allocatable: newCountQuantity(allocatable),
+		Requests:    newCountQuantity(requests),
+		Limits:      newCountQuantity(limits),
+	}
+	if f.Requests != nil {
+		f.RequestsPercent = documentPercent(float64(f.Requests.Count), float64(f.Allocatable.Count))
+	}
+	return f
+}
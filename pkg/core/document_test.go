@@ -0,0 +1,228 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func newDocumentTestSnapshot(metrics bool) Snapshot {
+	q := func(s string) *resource.Quantity {
+		v := resource.MustParse(s)
+		return &v
+	}
+	node := &NodeStats{
+		Status:                  "Ready",
+		CapacityCPU:             q("4"),
+		AllocatableCPU:          q("4"),
+		AllocatedCPUrequests:    resource.MustParse("1500m"),
+		AllocatedCPULimits:      resource.MustParse("3"),
+		CapacityMemory:          q("16Gi"),
+		AllocatableMemory:       q("16Gi"),
+		AllocatedMemoryRequests: resource.MustParse("4Gi"),
+		AllocatedMemoryLimits:   resource.MustParse("8Gi"),
+		PodCount:                7,
+		CreationTime:            time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	totals := Totals{
+		TotalCapacityCPU:             q("4"),
+		TotalAllocatableCPU:          q("4"),
+		TotalAllocatedCPUrequests:    q("1500m"),
+		TotalAllocatedCPULimits:      q("3"),
+		TotalCapacityMemory:          q("16Gi"),
+		TotalAllocatableMemory:       q("16Gi"),
+		TotalAllocatedMemoryRequests: q("4Gi"),
+		TotalAllocatedMemoryLimits:   q("8Gi"),
+		PendingPods:                  2,
+		TotalPendingCPURequests:      q("500m"),
+		MetricsAvailable:             metrics,
+	}
+	if metrics {
+		node.UsageCPU, node.UsageMemory = q("1"), q("2Gi")
+		totals.TotalUsageCPU, totals.TotalUsageMemory = q("1"), q("2Gi")
+	}
+	return NewSnapshot(NodeMap{"node-b": node, "node-a": {Status: "NotReady"}}, totals)
+}
+
+func TestNewSnapshotDocument(t *testing.T) {
+	doc := NewSnapshotDocument(newDocumentTestSnapshot(true), DocumentMetadata{Context: "prod"})
+
+	if doc.APIVersion != DocumentAPIVersion || doc.Kind != KindSnapshot {
+		t.Errorf("unexpected header: %s %s", doc.APIVersion, doc.Kind)
+	}
+	if !doc.Metadata.MetricsAvailable || doc.Metadata.Context != "prod" {
+		t.Errorf("unexpected metadata: %+v", doc.Metadata)
+	}
+	if len(doc.Nodes) != 2 || doc.Nodes[0].Name != "node-a" || doc.Nodes[1].Name != "node-b" {
+		t.Fatalf("expected nodes sorted by name, got %+v", doc.Nodes)
+	}
+
+	n := doc.Nodes[1]
+	if n.CPU.Allocatable.Cores != 4 || n.CPU.Requests.Cores != 1.5 || n.CPU.Requests.Value != "1500m" {
+		t.Errorf("unexpected node CPU: %+v", n.CPU)
+	}
+	if n.CPU.RequestsPercent != 37.5 || n.CPU.LimitsPercent != 75 || n.CPU.UsagePercent == nil || *n.CPU.UsagePercent != 25 {
+		t.Errorf("unexpected node CPU percentages: %+v", n.CPU)
+	}
+	if n.Memory.Allocatable.Bytes != 16<<30 || n.Memory.Usage.Bytes != 2<<30 || n.Memory.RequestsPercent != 25 {
+		t.Errorf("unexpected node memory: %+v", n.Memory)
+	}
+	if n.GPU != nil {
+		t.Errorf("expected no GPU figures without allocatable GPUs, got %+v", n.GPU)
+	}
+	if n.CreatedAt == nil || doc.Nodes[0].CreatedAt != nil {
+		t.Errorf("expected createdAt only when known")
+	}
+
+	if doc.Totals.Nodes != 2 || doc.Totals.Pods != 7 || doc.Totals.Pending.Pods != 2 {
+		t.Errorf("unexpected totals: %+v", doc.Totals)
+	}
+	if doc.Totals.Pending.CPURequests.Cores != 0.5 || doc.Totals.Pending.MemoryRequests.Value != "0" {
+		t.Errorf("unexpected pending demand: %+v", doc.Totals.Pending)
+	}
+}
+
+func TestNewSnapshotDocument_NoMetrics(t *testing.T) {
+	doc := NewSnapshotDocument(newDocumentTestSnapshot(false), DocumentMetadata{})
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(b, &obj); err != nil {
+		t.Fatal(err)
+	}
+	cpu := obj["totals"].(map[string]any)["cpu"].(map[string]any)
+	if v, ok := cpu["usage"]; !ok || v != nil {
+		t.Errorf("expected null totals usage, got %v", v)
+	}
+	if v, ok := cpu["usagePercent"]; !ok || v != nil {
+		t.Errorf("expected null totals usagePercent, got %v", v)
+	}
+	if obj["metadata"].(map[string]any)["metricsAvailable"] != false {
+		t.Errorf("expected metricsAvailable false, got %v", obj["metadata"])
+	}
+}
+
+func TestSnapshotDocument_MatchesSchema(t *testing.T) {
+	var schema map[string]any
+	if err := json.Unmarshal(SnapshotSchema, &schema); err != nil {
+		t.Fatalf("invalid schema: %v", err)
+	}
+
+	docs := map[string]any{
+		"snapshot":    NewSnapshotDocument(newDocumentTestSnapshot(true), DocumentMetadata{Context: "prod"}),
+		"no-metrics":  NewSnapshotDocument(newDocumentTestSnapshot(false), DocumentMetadata{}),
+		"empty":       NewSnapshotDocument(Snapshot{}, DocumentMetadata{Error: "timeout"}),
+		"list":        NewSnapshotListDocument([]SnapshotDocument{NewSnapshotDocument(newDocumentTestSnapshot(true), DocumentMetadata{})}),
+		"empty-list":  NewSnapshotListDocument(nil),
+		"wrong-kind":  map[string]any{"apiVersion": "glance/v1", "kind": "Other"},
+		"wrong-value": map[string]any{"apiVersion": "glance/v1", "kind": "SnapshotList", "items": "none"},
+	}
+	for name, doc := range docs {
+		b, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v any
+		if err := json.Unmarshal(b, &v); err != nil {
+			t.Fatal(err)
+		}
+		err = validateSchema(schema, schema, v, "$")
+		wantErr := strings.HasPrefix(name, "wrong-")
+		if (err != nil) != wantErr {
+			t.Errorf("%s: validation error %v, want error %v", name, err, wantErr)
+		}
+	}
+}
+
+// validateSchema checks v against the subset of JSON Schema used by
+// snapshot-v1.json: $ref to #/$defs, oneOf, const, type, required,
+// properties and items.
+func validateSchema(root, schema map[string]any, v any, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		def, ok := root["$defs"].(map[string]any)[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: unresolved $ref %s", path, ref)
+		}
+		return validateSchema(root, def, v, path)
+	}
+	if oneOf, ok := schema["oneOf"].([]any); ok {
+		matches := 0
+		for _, s := range oneOf {
+			if validateSchema(root, s.(map[string]any), v, path) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fmt.Errorf("%s: matches %d oneOf schemas", path, matches)
+		}
+	}
+	if c, ok := schema["const"]; ok && c != v {
+		return fmt.Errorf("%s: expected %v, got %v", path, c, v)
+	}
+	if typ, ok := schema["type"].(string); ok && !schemaTypeMatches(typ, v) {
+		return fmt.Errorf("%s: expected %s, got %T", path, typ, v)
+	}
+	if obj, ok := v.(map[string]any); ok {
+		for _, r := range asSlice(schema["required"]) {
+			if _, ok := obj[r.(string)]; !ok {
+				return fmt.Errorf("%s: missing required %s", path, r)
+			}
+		}
+		props, _ := schema["properties"].(map[string]any)
+		for key, value := range obj {
+			if s, ok := props[key].(map[string]any); ok {
+				if err := validateSchema(root, s, value, path+"."+key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if list, ok := v.([]any); ok {
+		if s, ok := schema["items"].(map[string]any); ok {
+			for i, item := range list {
+				if err := validateSchema(root, s, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func schemaTypeMatches(typ string, v any) bool {
+	switch typ {
+	case "null":
+		return v == nil
+	case "object":
+		_, ok := v.(map[string]any)
+		return ok
+	case "array":
+		_, ok := v.([]any)
+		return ok
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "number":
+		_, ok := v.(float64)
+		return ok
+	case "integer":
+		f, ok := v.(float64)
+		return ok && f == float64(int64(f))
+	}
+	return false
+}
+
+func asSlice(v any) []any {
+	s, _ := v.([]any)
+	return s
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// IsUnscheduledPod returns true if the pod is Pending and has not yet been
+// bound to a node, i.e. it represents demand the scheduler could not place.
+func IsUnscheduledPod(pod *v1.Pod) bool {
+	return pod.Spec.NodeName == "" && pod.Status.Phase == v1.PodPending
+}
+
+// ApplyPendingDemand records the count and summed CPU/memory requests of
+// unscheduled pods on totals. Pods that are already bound to a node or are
+// not Pending are ignored, so callers may pass an unfiltered pod list.
+func ApplyPendingDemand(totals *Totals, pods []v1.Pod) {
+	cpuReq := resource.NewMilliQuantity(0, resource.DecimalSI)
+	memReq := resource.NewQuantity(0, resource.BinarySI)
+	count := 0
+
+	for i := range pods {
+		if !IsUnscheduledPod(&pods[i]) {
+			continue
+		}
+		count++
+		for _, container := range pods[i].Spec.Containers {
+			if req := container.Resources.Requests.Cpu(); req != nil {
+				cpuReq.Add(*req)
+			}
+			if req := container.Resources.Requests.Memory(); req != nil {
+				memReq.Add(*req)
+			}
+		}
+	}
+
+	totals.PendingPods = count
+	totals.TotalPendingCPURequests = cpuReq
+	totals.TotalPendingMemoryRequests = memReq
+}
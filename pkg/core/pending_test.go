@@ -0,0 +1,69 @@
+package core
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestApplyPendingDemand(t *testing.T) {
+	requests := v1.ResourceRequirements{
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    *resource.NewMilliQuantity(500, resource.DecimalSI),
+			v1.ResourceMemory: *resource.NewQuantity(1024*1024*1024, resource.BinarySI),
+		},
+	}
+
+	pods := []v1.Pod{
+		// Unscheduled pending pod with two containers: counted.
+		{
+			Spec: v1.PodSpec{Containers: []v1.Container{
+				{Resources: requests},
+				{Resources: requests},
+			}},
+			Status: v1.PodStatus{Phase: v1.PodPending},
+		},
+		// Pending but already bound (e.g. pulling images): ignored.
+		{
+			Spec: v1.PodSpec{
+				NodeName:   "node-1",
+				Containers: []v1.Container{{Resources: requests}},
+			},
+			Status: v1.PodStatus{Phase: v1.PodPending},
+		},
+		// Running pod: ignored.
+		{
+			Spec: v1.PodSpec{
+				NodeName:   "node-1",
+				Containers: []v1.Container{{Resources: requests}},
+			},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		},
+	}
+
+	var totals Totals
+	ApplyPendingDemand(&totals, pods)
+
+	if totals.PendingPods != 1 {
+		t.Errorf("expected 1 pending pod, got %d", totals.PendingPods)
+	}
+	if got := totals.TotalPendingCPURequests.MilliValue(); got != 1000 {
+		t.Errorf("expected pending CPU requests 1000m, got %dm", got)
+	}
+	if got := totals.TotalPendingMemoryRequests.Value(); got != 2*1024*1024*1024 {
+		t.Errorf("expected pending memory requests 2Gi, got %d", got)
+	}
+}
+
+func TestApplyPendingDemand_NoPods(t *testing.T) {
+	var totals Totals
+	ApplyPendingDemand(&totals, nil)
+
+	if totals.PendingPods != 0 {
+		t.Errorf("expected 0 pending pods, got %d", totals.PendingPods)
+	}
+	if totals.TotalPendingCPURequests == nil || !totals.TotalPendingCPURequests.IsZero() {
+		t.Errorf("expected zero pending CPU requests, got %v", totals.TotalPendingCPURequests)
+	}
+}
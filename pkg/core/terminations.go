@@ -0,0 +1,53 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// Well-known termination and pod status reasons.
+const (
+	ReasonOOMKilled = "OOMKilled"
+	ReasonEvicted   = "Evicted"
+)
+
+// LastTermination returns the most recent termination recorded for a
+// container: the current state if the container is terminated right now,
+// otherwise the last termination state. It returns nil if the container has
+// never terminated.
+func LastTermination(cs *v1.ContainerStatus) *v1.ContainerStateTerminated {
+	if cs.State.Terminated != nil {
+		return cs.State.Terminated
+	}
+	return cs.LastTerminationState.Terminated
+}
+
+// IsEvicted returns true if the pod was evicted by the kubelet (e.g. due to
+// node memory or disk pressure).
+func IsEvicted(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodFailed && pod.Status.Reason == ReasonEvicted
+}
+
+// CountOOMKills returns the number of containers in the pod whose most
+// recent termination was an OOM kill.
+func CountOOMKills(pod *v1.Pod) int {
+	count := 0
+	for i := range pod.Status.ContainerStatuses {
+		if term := LastTermination(&pod.Status.ContainerStatuses[i]); term != nil && term.Reason == ReasonOOMKilled {
+			count++
+		}
+	}
+	return count
+}
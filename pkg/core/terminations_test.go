@@ -0,0 +1,85 @@
+package core
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestLastTermination(t *testing.T) {
+	current := &v1.ContainerStateTerminated{Reason: "Error"}
+	previous := &v1.ContainerStateTerminated{Reason: ReasonOOMKilled}
+
+	tests := []struct {
+		name string
+		cs   v1.ContainerStatus
+		want string
+	}{
+		{
+			name: "never terminated",
+			cs:   v1.ContainerStatus{},
+			want: "",
+		},
+		{
+			name: "previous termination only",
+			cs: v1.ContainerStatus{
+				LastTerminationState: v1.ContainerState{Terminated: previous},
+			},
+			want: ReasonOOMKilled,
+		},
+		{
+			name: "currently terminated wins",
+			cs: v1.ContainerStatus{
+				State:                v1.ContainerState{Terminated: current},
+				LastTerminationState: v1.ContainerState{Terminated: previous},
+			},
+			want: "Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if term := LastTermination(&tt.cs); term != nil {
+				got = term.Reason
+			}
+			if got != tt.want {
+				t.Errorf("LastTermination() reason = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountOOMKills(t *testing.T) {
+	pod := &v1.Pod{
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "app", LastTerminationState: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{Reason: ReasonOOMKilled},
+				}},
+				{Name: "sidecar", LastTerminationState: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{Reason: "Error"},
+				}},
+				{Name: "worker", State: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{Reason: ReasonOOMKilled},
+				}},
+			},
+		},
+	}
+
+	if got := CountOOMKills(pod); got != 2 {
+		t.Errorf("expected 2 OOM kills, got %d", got)
+	}
+}
+
+func TestIsEvicted(t *testing.T) {
+	evicted := &v1.Pod{Status: v1.PodStatus{Phase: v1.PodFailed, Reason: ReasonEvicted}}
+	failed := &v1.Pod{Status: v1.PodStatus{Phase: v1.PodFailed, Reason: "Error"}}
+
+	if !IsEvicted(evicted) {
+		t.Error("expected evicted pod to be reported as evicted")
+	}
+	if IsEvicted(failed) {
+		t.Error("expected failed pod not to be reported as evicted")
+	}
+}
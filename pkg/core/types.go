@@ -70,6 +70,12 @@ type NodeStats struct {
 	PodInfo                 map[string]*PodInfo `json:",omitempty"`
 	CreationTime            time.Time           `json:",omitempty"`
 	PodCount                int                 `json:",omitempty"`
+	OOMKills                int                 `json:",omitempty"` // containers whose last termination was OOMKilled
+	UsageEphemeralStorage   *resource.Quantity  `json:",omitempty"` // sum of pod ephemeral storage (kubelet source)
+	FSUsed                  *resource.Quantity  `json:",omitempty"` // node root filesystem (kubelet source)
+	FSCapacity              *resource.Quantity  `json:",omitempty"`
+	NetworkRxBytes          *resource.Quantity  `json:",omitempty"` // cumulative, default interface (kubelet source)
+	NetworkTxBytes          *resource.Quantity  `json:",omitempty"`
 }
 
 // NodeMap is a map of node names to their statistics.
@@ -114,6 +120,13 @@ type Totals struct {
 	TotalAllocatedGPULimits      *resource.Quantity `json:",omitempty"`
 	TotalUsageCPU                *resource.Quantity `json:",omitempty"`
 	TotalUsageMemory             *resource.Quantity `json:",omitempty"`
+	PendingPods                  int                `json:",omitempty"`
+	TotalPendingCPURequests      *resource.Quantity `json:",omitempty"`
+	TotalPendingMemoryRequests   *resource.Quantity `json:",omitempty"`
+	TotalOOMKills                int                `json:",omitempty"` // containers whose last termination was OOMKilled
+	// MetricsAvailable is false when usage metrics could not be read (or
+	// were turned off) and usage totals and per-node usage are unknown.
+	MetricsAvailable bool `json:"metricsAvailable"`
 }
 
 // Glance holds the complete cluster state including per-node statistics and totals.
@@ -134,3 +147,12 @@ func NewSnapshot(nodes NodeMap, totals Totals) Snapshot {
 		Totals: totals,
 	}
 }
+
+// ClusterSnapshot is one cluster's entry in a multi-cluster (fleet) view.
+// Error is set, and the snapshot left empty, when the cluster could not be
+// read.
+type ClusterSnapshot struct {
+	Context string
+	Snapshot
+	Error string `json:",omitempty"`
+}
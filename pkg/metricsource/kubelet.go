@@ -0,0 +1,361 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// summaryCacheTTL lets NodeMetrics, PodMetrics and the extended usage
+	// calls made for a single render share one round of summary requests.
+	summaryCacheTTL = 2 * time.Second
+	// summaryRequestTimeout bounds each per-node proxy call so a single
+	// unreachable kubelet cannot stall a refresh.
+	summaryRequestTimeout = 10 * time.Second
+)
+
+// ExtendedUsage holds usage that metrics.k8s.io does not expose. Nil fields
+// mean the backend did not report a value.
+type ExtendedUsage struct {
+	EphemeralStorage *resource.Quantity `json:",omitempty"`
+	FSUsed           *resource.Quantity `json:",omitempty"`
+	FSCapacity       *resource.Quantity `json:",omitempty"`
+	NetworkRxBytes   *resource.Quantity `json:",omitempty"` // cumulative since interface start
+	NetworkTxBytes   *resource.Quantity `json:",omitempty"` // cumulative since interface start
+}
+
+// ExtendedSource is implemented by backends that report storage and network
+// usage in addition to CPU and memory.
+type ExtendedSource interface {
+	Source
+	// NodeExtendedUsage returns usage keyed by node name.
+	NodeExtendedUsage(ctx context.Context) (map[string]ExtendedUsage, error)
+	// PodExtendedUsage returns usage keyed by PodKey(namespace, name).
+	PodExtendedUsage(ctx context.Context, namespace string) (map[string]ExtendedUsage, error)
+}
+
+// KubeletSummary reads the kubelet Summary API for every node through the
+// API server proxy (/api/v1/nodes/<node>/proxy/stats/summary). It works
+// without metrics-server and additionally reports ephemeral storage,
+// filesystem and network usage.
+type KubeletSummary struct {
+	client        kubernetes.Interface
+	maxConcurrent int
+	// fetch retrieves the raw summary for a node; replaced in tests.
+	fetch func(ctx context.Context, node string) ([]byte, error)
+
+	mu        sync.Mutex
+	cached    []kubeletSummary
+	cachedAt  time.Time
+	cachedErr error
+}
+
+// NewKubeletSummary returns a Source backed by the kubelet Summary API.
+// maxConcurrent bounds the number of in-flight node requests.
+func NewKubeletSummary(client kubernetes.Interface, maxConcurrent int) *KubeletSummary {
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+	k := &KubeletSummary{client: client, maxConcurrent: maxConcurrent}
+	k.fetch = k.fetchFromProxy
+	return k
+}
+
+// Name implements Source.
+func (k *KubeletSummary) Name() string {
+	return SourceKubelet
+}
+
+// NodeMetrics implements Source.
+func (k *KubeletSummary) NodeMetrics(ctx context.Context) (map[string]*metricsv1beta1.NodeMetrics, error) {
+	summaries, err := k.summaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*metricsv1beta1.NodeMetrics, len(summaries))
+	for _, s := range summaries {
+		n := s.Node
+		nm := &metricsv1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: n.NodeName},
+			Usage:      v1.ResourceList{},
+		}
+		if n.CPU != nil {
+			nm.Timestamp = n.CPU.Time
+			if n.CPU.UsageNanoCores != nil {
+				nm.Usage[v1.ResourceCPU] = *resource.NewScaledQuantity(int64(*n.CPU.UsageNanoCores), resource.Nano)
+			}
+		}
+		if n.Memory != nil && n.Memory.WorkingSetBytes != nil {
+			nm.Usage[v1.ResourceMemory] = *resource.NewQuantity(int64(*n.Memory.WorkingSetBytes), resource.BinarySI)
+		}
+		result[n.NodeName] = nm
+	}
+	return result, nil
+}
+
+// PodMetrics implements Source.
+func (k *KubeletSummary) PodMetrics(ctx context.Context, namespace string) (map[string]*metricsv1beta1.PodMetrics, error) {
+	summaries, err := k.summaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*metricsv1beta1.PodMetrics)
+	for _, s := range summaries {
+		for _, p := range s.Pods {
+			if namespace != "" && p.PodRef.Namespace != namespace {
+				continue
+			}
+			pm := &metricsv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{Name: p.PodRef.Name, Namespace: p.PodRef.Namespace},
+			}
+			for _, c := range p.Containers {
+				usage := v1.ResourceList{}
+				if c.CPU != nil && c.CPU.UsageNanoCores != nil {
+					usage[v1.ResourceCPU] = *resource.NewScaledQuantity(int64(*c.CPU.UsageNanoCores), resource.Nano)
+				}
+				if c.Memory != nil && c.Memory.WorkingSetBytes != nil {
+					usage[v1.ResourceMemory] = *resource.NewQuantity(int64(*c.Memory.WorkingSetBytes), resource.BinarySI)
+				}
+				pm.Containers = append(pm.Containers, metricsv1beta1.ContainerMetrics{Name: c.Name, Usage: usage})
+			}
+			result[PodKey(p.PodRef.Namespace, p.PodRef.Name)] = pm
+		}
+	}
+	return result, nil
+}
+
+// NodeExtendedUsage implements ExtendedSource. Ephemeral storage is the sum
+// of pod ephemeral storage on the node; filesystem usage is the node's root
+// filesystem.
+func (k *KubeletSummary) NodeExtendedUsage(ctx context.Context) (map[string]ExtendedUsage, error) {
+	summaries, err := k.summaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]ExtendedUsage, len(summaries))
+	for _, s := range summaries {
+		var u ExtendedUsage
+		ephemeral := resource.NewQuantity(0, resource.BinarySI)
+		seenEphemeral := false
+		for _, p := range s.Pods {
+			if p.EphemeralStorage != nil && p.EphemeralStorage.UsedBytes != nil {
+				ephemeral.Add(*bytesQuantity(p.EphemeralStorage.UsedBytes))
+				seenEphemeral = true
+			}
+		}
+		if seenEphemeral {
+			u.EphemeralStorage = ephemeral
+		}
+		if fs := s.Node.Fs; fs != nil {
+			u.FSUsed = bytesQuantity(fs.UsedBytes)
+			u.FSCapacity = bytesQuantity(fs.CapacityBytes)
+		}
+		if net := s.Node.Network; net != nil {
+			u.NetworkRxBytes = bytesQuantity(net.RxBytes)
+			u.NetworkTxBytes = bytesQuantity(net.TxBytes)
+		}
+		result[s.Node.NodeName] = u
+	}
+	return result, nil
+}
+
+// PodExtendedUsage implements ExtendedSource.
+func (k *KubeletSummary) PodExtendedUsage(ctx context.Context, namespace string) (map[string]ExtendedUsage, error) {
+	summaries, err := k.summaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]ExtendedUsage)
+	for _, s := range summaries {
+		for _, p := range s.Pods {
+			if namespace != "" && p.PodRef.Namespace != namespace {
+				continue
+			}
+			var u ExtendedUsage
+			if es := p.EphemeralStorage; es != nil {
+				u.EphemeralStorage = bytesQuantity(es.UsedBytes)
+				u.FSUsed = bytesQuantity(es.UsedBytes)
+				u.FSCapacity = bytesQuantity(es.CapacityBytes)
+			}
+			if net := p.Network; net != nil {
+				u.NetworkRxBytes = bytesQuantity(net.RxBytes)
+				u.NetworkTxBytes = bytesQuantity(net.TxBytes)
+			}
+			result[PodKey(p.PodRef.Namespace, p.PodRef.Name)] = u
+		}
+	}
+	return result, nil
+}
+
+// summaries returns the per-node summaries, fetching them at most once per
+// summaryCacheTTL. Concurrent callers wait for the in-flight fetch.
+func (k *KubeletSummary) summaries(ctx context.Context) ([]kubeletSummary, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if !k.cachedAt.IsZero() && time.Since(k.cachedAt) < summaryCacheTTL {
+		return k.cached, k.cachedErr
+	}
+
+	k.cached, k.cachedErr = k.fetchAll(ctx)
+	k.cachedAt = time.Now()
+	return k.cached, k.cachedErr
+}
+
+// fetchAll requests the summary for every node with at most maxConcurrent
+// requests in flight. Nodes whose kubelet cannot be reached are skipped;
+// an error is returned only if no node could be read.
+func (k *KubeletSummary) fetchAll(ctx context.Context) ([]kubeletSummary, error) {
+	nodes, err := k.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	if len(nodes.Items) == 0 {
+		return nil, nil
+	}
+
+	results := make([]*kubeletSummary, len(nodes.Items))
+	errs := make([]error, len(nodes.Items))
+	sem := make(chan struct{}, k.maxConcurrent)
+	var wg sync.WaitGroup
+
+	for i := range nodes.Items {
+		wg.Add(1)
+		go func(idx int, name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			reqCtx, cancel := context.WithTimeout(ctx, summaryRequestTimeout)
+			defer cancel()
+
+			raw, err := k.fetch(reqCtx, name)
+			if err != nil {
+				errs[idx] = fmt.Errorf("node %s: %w", name, err)
+				return
+			}
+			var s kubeletSummary
+			if err := json.Unmarshal(raw, &s); err != nil {
+				errs[idx] = fmt.Errorf("node %s: failed to decode summary: %w", name, err)
+				return
+			}
+			if s.Node.NodeName == "" {
+				s.Node.NodeName = name
+			}
+			results[idx] = &s
+		}(i, nodes.Items[i].Name)
+	}
+	wg.Wait()
+
+	summaries := make([]kubeletSummary, 0, len(results))
+	var firstErr error
+	for i, s := range results {
+		if s != nil {
+			summaries = append(summaries, *s)
+			continue
+		}
+		log.Debugf("Skipping kubelet summary: %v", errs[i])
+		if firstErr == nil {
+			firstErr = errs[i]
+		}
+	}
+	if len(summaries) == 0 {
+		return nil, fmt.Errorf("kubelet summary API unavailable on all nodes: %w", firstErr)
+	}
+	return summaries, nil
+}
+
+// fetchFromProxy reads a node's summary through the API server node proxy.
+func (k *KubeletSummary) fetchFromProxy(ctx context.Context, node string) ([]byte, error) {
+	return k.client.CoreV1().RESTClient().Get().
+		AbsPath("/api/v1/nodes", node, "proxy", "stats", "summary").
+		DoRaw(ctx)
+}
+
+// bytesQuantity converts an optional byte count to a binary quantity.
+func bytesQuantity(b *uint64) *resource.Quantity {
+	if b == nil {
+		return nil
+	}
+	return resource.NewQuantity(int64(*b), resource.BinarySI)
+}
+
+// The types below mirror the subset of the kubelet stats/v1alpha1 Summary
+// API that glance reads.
+
+type kubeletSummary struct {
+	Node kubeletNodeStats  `json:"node"`
+	Pods []kubeletPodStats `json:"pods"`
+}
+
+type kubeletNodeStats struct {
+	NodeName string              `json:"nodeName"`
+	CPU      *kubeletCPUStats    `json:"cpu,omitempty"`
+	Memory   *kubeletMemoryStats `json:"memory,omitempty"`
+	Network  *kubeletNetStats    `json:"network,omitempty"`
+	Fs       *kubeletFsStats     `json:"fs,omitempty"`
+}
+
+type kubeletPodStats struct {
+	PodRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"podRef"`
+	Containers       []kubeletContainerStats `json:"containers"`
+	Network          *kubeletNetStats        `json:"network,omitempty"`
+	EphemeralStorage *kubeletFsStats         `json:"ephemeral-storage,omitempty"`
+}
+
+type kubeletContainerStats struct {
+	Name   string              `json:"name"`
+	CPU    *kubeletCPUStats    `json:"cpu,omitempty"`
+	Memory *kubeletMemoryStats `json:"memory,omitempty"`
+}
+
+type kubeletCPUStats struct {
+	Time           metav1.Time `json:"time"`
+	UsageNanoCores *uint64     `json:"usageNanoCores,omitempty"`
+}
+
+type kubeletMemoryStats struct {
+	WorkingSetBytes *uint64 `json:"workingSetBytes,omitempty"`
+}
+
+// kubeletNetStats holds the default interface's counters, which the
+// Summary API inlines at the top level of the network stats.
+type kubeletNetStats struct {
+	RxBytes *uint64 `json:"rxBytes,omitempty"`
+	TxBytes *uint64 `json:"txBytes,omitempty"`
+}
+
+type kubeletFsStats struct {
+	CapacityBytes *uint64 `json:"capacityBytes,omitempty"`
+	UsedBytes     *uint64 `json:"usedBytes,omitempty"`
+}
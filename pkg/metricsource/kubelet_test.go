@@ -0,0 +1,202 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const summaryNode1 = `{
+  "node": {
+    "nodeName": "node-1",
+    "cpu": {"time": "2025-01-01T00:00:00Z", "usageNanoCores": 1500000000},
+    "memory": {"workingSetBytes": 2147483648},
+    "network": {"name": "eth0", "rxBytes": 1000, "txBytes": 2000},
+    "fs": {"capacityBytes": 107374182400, "usedBytes": 10737418240}
+  },
+  "pods": [
+    {
+      "podRef": {"name": "api-0", "namespace": "payments"},
+      "containers": [
+        {"name": "app", "cpu": {"usageNanoCores": 200000000}, "memory": {"workingSetBytes": 104857600}},
+        {"name": "sidecar", "cpu": {"usageNanoCores": 50000000}}
+      ],
+      "network": {"rxBytes": 10, "txBytes": 20},
+      "ephemeral-storage": {"capacityBytes": 107374182400, "usedBytes": 1048576}
+    },
+    {
+      "podRef": {"name": "coredns", "namespace": "kube-system"},
+      "containers": [{"name": "coredns", "memory": {"workingSetBytes": 1024}}],
+      "ephemeral-storage": {"usedBytes": 4096}
+    }
+  ]
+}`
+
+func newTestNodes(names ...string) *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	for _, n := range names {
+		node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: n}}
+		_, _ = client.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{})
+	}
+	return client
+}
+
+func TestKubeletSummary_NodeAndPodMetrics(t *testing.T) {
+	src := NewKubeletSummary(newTestNodes("node-1"), 4)
+	var calls int32
+	src.fetch = func(_ context.Context, node string) ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		return []byte(summaryNode1), nil
+	}
+
+	nodes, err := src.NodeMetrics(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	n1, ok := nodes["node-1"]
+	if !ok {
+		t.Fatalf("expected metrics for node-1, got %v", nodes)
+	}
+	if got := n1.Usage.Cpu().MilliValue(); got != 1500 {
+		t.Errorf("expected node-1 CPU 1500m, got %dm", got)
+	}
+	if got := n1.Usage.Memory().Value(); got != 2*1024*1024*1024 {
+		t.Errorf("expected node-1 memory 2Gi, got %d", got)
+	}
+
+	pods, err := src.PodMetrics(context.Background(), "payments")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 1 {
+		t.Fatalf("expected only payments pods, got %d", len(pods))
+	}
+	pm := pods[PodKey("payments", "api-0")]
+	if pm == nil || len(pm.Containers) != 2 {
+		t.Fatalf("expected 2 containers for payments/api-0, got %+v", pm)
+	}
+	if got := pm.Containers[0].Usage.Cpu().MilliValue(); got != 200 {
+		t.Errorf("expected app CPU 200m, got %dm", got)
+	}
+	if _, ok := pm.Containers[1].Usage[v1.ResourceMemory]; ok {
+		t.Error("expected sidecar to have no memory usage")
+	}
+
+	if calls != 1 {
+		t.Errorf("expected summaries to be cached across calls, got %d fetches", calls)
+	}
+}
+
+func TestKubeletSummary_ExtendedUsage(t *testing.T) {
+	src := NewKubeletSummary(newTestNodes("node-1"), 1)
+	src.fetch = func(context.Context, string) ([]byte, error) {
+		return []byte(summaryNode1), nil
+	}
+
+	nodes, err := src.NodeExtendedUsage(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	u := nodes["node-1"]
+	if u.EphemeralStorage == nil || u.EphemeralStorage.Value() != 1048576+4096 {
+		t.Errorf("expected node ephemeral storage to sum pods, got %v", u.EphemeralStorage)
+	}
+	if u.FSUsed == nil || u.FSUsed.Value() != 10*1024*1024*1024 {
+		t.Errorf("expected FS used 10Gi, got %v", u.FSUsed)
+	}
+	if u.NetworkRxBytes == nil || u.NetworkRxBytes.Value() != 1000 || u.NetworkTxBytes.Value() != 2000 {
+		t.Errorf("expected network 1000/2000, got %v/%v", u.NetworkRxBytes, u.NetworkTxBytes)
+	}
+
+	pods, err := src.PodExtendedUsage(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	api := pods[PodKey("payments", "api-0")]
+	if api.EphemeralStorage == nil || api.EphemeralStorage.Value() != 1048576 {
+		t.Errorf("expected api-0 ephemeral 1Mi, got %v", api.EphemeralStorage)
+	}
+	if dns := pods[PodKey("kube-system", "coredns")]; dns.NetworkRxBytes != nil {
+		t.Errorf("expected coredns to have no network usage, got %v", dns.NetworkRxBytes)
+	}
+}
+
+func TestKubeletSummary_BoundedConcurrency(t *testing.T) {
+	names := make([]string, 10)
+	for i := range names {
+		names[i] = fmt.Sprintf("node-%d", i)
+	}
+	src := NewKubeletSummary(newTestNodes(names...), 3)
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	src.fetch = func(_ context.Context, node string) ([]byte, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return []byte(fmt.Sprintf(`{"node":{"nodeName":%q}}`, node)), nil
+	}
+
+	nodes, err := src.NodeMetrics(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(nodes) != len(names) {
+		t.Errorf("expected %d nodes, got %d", len(names), len(nodes))
+	}
+	if maxInFlight > 3 {
+		t.Errorf("expected at most 3 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestKubeletSummary_PartialAndTotalFailure(t *testing.T) {
+	src := NewKubeletSummary(newTestNodes("node-1", "node-2"), 2)
+	src.fetch = func(_ context.Context, node string) ([]byte, error) {
+		if node == "node-2" {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return []byte(summaryNode1), nil
+	}
+	nodes, err := src.NodeMetrics(context.Background())
+	if err != nil {
+		t.Fatalf("expected unreachable node to be skipped, got %v", err)
+	}
+	if _, ok := nodes["node-2"]; ok || len(nodes) != 1 {
+		t.Errorf("expected only node-1, got %v", nodes)
+	}
+
+	src = NewKubeletSummary(newTestNodes("node-1"), 1)
+	src.fetch = func(context.Context, string) ([]byte, error) {
+		return nil, fmt.Errorf("forbidden")
+	}
+	if _, err := src.NodeMetrics(context.Background()); err == nil {
+		t.Error("expected error when no node summary could be read")
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsource
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// MetricsServer reads instantaneous usage from the metrics.k8s.io API
+// (metrics-server or a compatible provider).
+type MetricsServer struct {
+	client metricsclientset.Interface
+}
+
+// NewMetricsServer returns a Source backed by the metrics.k8s.io API.
+func NewMetricsServer(client metricsclientset.Interface) *MetricsServer {
+	return &MetricsServer{client: client}
+}
+
+// Name implements Source.
+func (m *MetricsServer) Name() string {
+	return SourceMetricsServer
+}
+
+// NodeMetrics implements Source.
+func (m *MetricsServer) NodeMetrics(ctx context.Context) (map[string]*metricsv1beta1.NodeMetrics, error) {
+	// Use ResourceVersion="0" to serve from the API server watch cache.
+	list, err := m.client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{
+		ResourceVersion: "0",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*metricsv1beta1.NodeMetrics, len(list.Items))
+	for i := range list.Items {
+		nm := &list.Items[i]
+		result[nm.Name] = nm
+	}
+	return result, nil
+}
+
+// PodMetrics implements Source.
+func (m *MetricsServer) PodMetrics(ctx context.Context, namespace string) (map[string]*metricsv1beta1.PodMetrics, error) {
+	list, err := m.client.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{
+		ResourceVersion: "0",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*metricsv1beta1.PodMetrics, len(list.Items))
+	for i := range list.Items {
+		pm := &list.Items[i]
+		result[PodKey(pm.Namespace, pm.Name)] = pm
+	}
+	return result, nil
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// cpuRateWindow is the range used to turn the cAdvisor CPU counter into
+	// a rate. It must cover several scrape intervals.
+	cpuRateWindow = "5m"
+	// subqueryStep is the resolution used when aggregating over a window.
+	subqueryStep = "1m"
+	// defaultPrometheusTimeout bounds each HTTP API call.
+	defaultPrometheusTimeout = 30 * time.Second
+
+	// cAdvisor series exported by the kubelet. The container!="" and
+	// container!="POD" matchers drop the pod-level and pause cgroups so
+	// usage is not double counted.
+	containerMatchers = `container!="",container!="POD"`
+)
+
+// PrometheusOptions configures a Prometheus-backed Source.
+type PrometheusOptions struct {
+	// Window is the period to aggregate over. Zero (or AggregationLatest)
+	// returns the most recent value.
+	Window time.Duration
+	// Aggregation reduces samples over Window into a single value.
+	Aggregation Aggregation
+	// HTTPClient overrides the client used to call the Prometheus API.
+	HTTPClient *http.Client
+}
+
+// Prometheus reads node and pod usage from cAdvisor metrics via the
+// Prometheus HTTP API. Nodes are identified by the "node" label, which is
+// added by the standard kubelet scrape configuration (e.g. kube-prometheus).
+type Prometheus struct {
+	baseURL     *url.URL
+	window      time.Duration
+	aggregation Aggregation
+	client      *http.Client
+}
+
+// NewPrometheus returns a Source that queries the Prometheus server at
+// baseURL (e.g. http://prometheus.monitoring:9090).
+func NewPrometheus(baseURL string, opts PrometheusOptions) (*Prometheus, error) {
+	if baseURL == "" {
+		return nil, fmt.Errorf("prometheus URL is required")
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prometheus URL %q: %w", baseURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid prometheus URL %q: scheme must be http or https", baseURL)
+	}
+
+	agg := opts.Aggregation
+	if agg == "" {
+		agg = AggregationLatest
+	}
+	if agg != AggregationLatest && opts.Window <= 0 {
+		return nil, fmt.Errorf("metrics aggregation %q requires a positive window", agg)
+	}
+
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: defaultPrometheusTimeout}
+	}
+
+	return &Prometheus{
+		baseURL:     u,
+		window:      opts.Window,
+		aggregation: agg,
+		client:      client,
+	}, nil
+}
+
+// Name implements Source.
+func (p *Prometheus) Name() string {
+	if p.aggregation == AggregationLatest {
+		return SourcePrometheus
+	}
+	return fmt.Sprintf("%s (%s over %s)", SourcePrometheus, p.aggregation, p.window)
+}
+
+// NodeMetrics implements Source.
+func (p *Prometheus) NodeMetrics(ctx context.Context) (map[string]*metricsv1beta1.NodeMetrics, error) {
+	cpuQuery := p.wrap(fmt.Sprintf(
+		`sum by (node) (rate(container_cpu_usage_seconds_total{%s}[%s]))`, containerMatchers, cpuRateWindow))
+	memQuery := p.wrap(fmt.Sprintf(
+		`sum by (node) (container_memory_working_set_bytes{%s})`, containerMatchers))
+
+	cpu, mem, err := p.queryPair(ctx, cpuQuery, memQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.Now()
+	result := make(map[string]*metricsv1beta1.NodeMetrics)
+	get := func(node string) *metricsv1beta1.NodeMetrics {
+		nm, ok := result[node]
+		if !ok {
+			nm = &metricsv1beta1.NodeMetrics{
+				ObjectMeta: metav1.ObjectMeta{Name: node},
+				Timestamp:  now,
+				Window:     metav1.Duration{Duration: p.window},
+				Usage:      v1.ResourceList{},
+			}
+			result[node] = nm
+		}
+		return nm
+	}
+
+	for _, s := range cpu {
+		if node := s.Metric["node"]; node != "" {
+			get(node).Usage[v1.ResourceCPU] = cpuQuantity(s.Value)
+		}
+	}
+	for _, s := range mem {
+		if node := s.Metric["node"]; node != "" {
+			get(node).Usage[v1.ResourceMemory] = memoryQuantity(s.Value)
+		}
+	}
+
+	return result, nil
+}
+
+// PodMetrics implements Source.
+func (p *Prometheus) PodMetrics(ctx context.Context, namespace string) (map[string]*metricsv1beta1.PodMetrics, error) {
+	matchers := containerMatchers
+	if namespace != "" {
+		matchers += fmt.Sprintf(`,namespace=%q`, namespace)
+	}
+	cpuQuery := p.wrap(fmt.Sprintf(
+		`sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{%s}[%s]))`, matchers, cpuRateWindow))
+	memQuery := p.wrap(fmt.Sprintf(
+		`sum by (namespace, pod, container) (container_memory_working_set_bytes{%s})`, matchers))
+
+	cpu, mem, err := p.queryPair(ctx, cpuQuery, memQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect usage per container first so containers can be emitted in a
+	// stable order.
+	type containerKey struct{ pod, container string }
+	usage := make(map[containerKey]v1.ResourceList)
+	pods := make(map[string]*metricsv1beta1.PodMetrics)
+
+	record := func(s promSample, name v1.ResourceName, q resource.Quantity) {
+		ns, pod, container := s.Metric["namespace"], s.Metric["pod"], s.Metric["container"]
+		if ns == "" || pod == "" || container == "" {
+			return
+		}
+		key := PodKey(ns, pod)
+		if _, ok := pods[key]; !ok {
+			pods[key] = &metricsv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{Name: pod, Namespace: ns},
+				Timestamp:  metav1.Now(),
+				Window:     metav1.Duration{Duration: p.window},
+			}
+		}
+		ck := containerKey{pod: key, container: container}
+		if usage[ck] == nil {
+			usage[ck] = v1.ResourceList{}
+		}
+		usage[ck][name] = q
+	}
+
+	for _, s := range cpu {
+		record(s, v1.ResourceCPU, cpuQuantity(s.Value))
+	}
+	for _, s := range mem {
+		record(s, v1.ResourceMemory, memoryQuantity(s.Value))
+	}
+
+	keys := make([]containerKey, 0, len(usage))
+	for k := range usage {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].pod != keys[j].pod {
+			return keys[i].pod < keys[j].pod
+		}
+		return keys[i].container < keys[j].container
+	})
+	for _, k := range keys {
+		pm := pods[k.pod]
+		pm.Containers = append(pm.Containers, metricsv1beta1.ContainerMetrics{
+			Name:  k.container,
+			Usage: usage[k],
+		})
+	}
+
+	return pods, nil
+}
+
+// wrap applies the configured aggregation to an instant-vector expression.
+func (p *Prometheus) wrap(expr string) string {
+	window := promDuration(p.window)
+	switch p.aggregation {
+	case AggregationAverage:
+		return fmt.Sprintf("avg_over_time((%s)[%s:%s])", expr, window, subqueryStep)
+	case AggregationP95:
+		return fmt.Sprintf("quantile_over_time(0.95, (%s)[%s:%s])", expr, window, subqueryStep)
+	default:
+		return expr
+	}
+}
+
+// queryPair runs the CPU and memory queries concurrently.
+func (p *Prometheus) queryPair(ctx context.Context, cpuQuery, memQuery string) ([]promSample, []promSample, error) {
+	var cpu, mem []promSample
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		cpu, err = p.query(gCtx, cpuQuery)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		mem, err = p.query(gCtx, memQuery)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return cpu, mem, nil
+}
+
+// promSample is a single instant-vector sample.
+type promSample struct {
+	Metric map[string]string
+	Value  float64
+}
+
+// promResponse mirrors the Prometheus HTTP API envelope for instant queries.
+type promResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// query runs an instant query against /api/v1/query.
+func (p *Prometheus) query(ctx context.Context, q string) ([]promSample, error) {
+	u := p.baseURL.JoinPath("api", "v1", "query")
+	u.RawQuery = url.Values{"query": {q}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("prometheus query failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prometheus response: %w", err)
+	}
+
+	var pr promResponse
+	if err := json.Unmarshal(body, &pr); err != nil {
+		return nil, fmt.Errorf("failed to decode prometheus response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if pr.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed (HTTP %d): %s: %s", resp.StatusCode, pr.ErrorType, pr.Error)
+	}
+	if pr.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("unexpected prometheus result type %q", pr.Data.ResultType)
+	}
+
+	samples := make([]promSample, 0, len(pr.Data.Result))
+	for _, r := range pr.Data.Result {
+		if len(r.Value) != 2 {
+			continue
+		}
+		str, ok := r.Value[1].(string)
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, promSample{Metric: r.Metric, Value: v})
+	}
+	return samples, nil
+}
+
+// promDuration formats d using Prometheus duration syntax (e.g. 1h30m, 90s).
+func promDuration(d time.Duration) string {
+	if d <= 0 {
+		return "0s"
+	}
+	s := d.Round(time.Second).String()
+	// time.Duration renders "1h0m0s"; Prometheus accepts it but trim the
+	// zero units for readability.
+	s = strings.Replace(s, "m0s", "m", 1)
+	s = strings.Replace(s, "h0m", "h", 1)
+	return s
+}
+
+// cpuQuantity converts CPU cores to a milli-CPU quantity.
+func cpuQuantity(cores float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(cores*1000+0.5), resource.DecimalSI)
+}
+
+// memoryQuantity converts a byte count to a binary quantity.
+func memoryQuantity(bytes float64) resource.Quantity {
+	return *resource.NewQuantity(int64(bytes+0.5), resource.BinarySI)
+}
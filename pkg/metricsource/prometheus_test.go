@@ -0,0 +1,234 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// stubPrometheus serves canned instant-query results. Queries are matched
+// by metric name; every received query is recorded for assertions.
+type stubPrometheus struct {
+	mu      sync.Mutex
+	queries []string
+	cpu     []map[string]interface{}
+	mem     []map[string]interface{}
+}
+
+func sample(labels map[string]string, value string) map[string]interface{} {
+	return map[string]interface{}{
+		"metric": labels,
+		"value":  []interface{}{1700000000.0, value},
+	}
+}
+
+func (s *stubPrometheus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/v1/query" {
+		http.NotFound(w, r)
+		return
+	}
+	q := r.URL.Query().Get("query")
+	s.mu.Lock()
+	s.queries = append(s.queries, q)
+	s.mu.Unlock()
+
+	result := s.mem
+	if strings.Contains(q, "container_cpu_usage_seconds_total") {
+		result = s.cpu
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"resultType": "vector",
+			"result":     result,
+		},
+	})
+}
+
+func TestPrometheus_NodeMetrics(t *testing.T) {
+	stub := &stubPrometheus{
+		cpu: []map[string]interface{}{
+			sample(map[string]string{"node": "node-1"}, "1.5"),
+			sample(map[string]string{"node": "node-2"}, "0.25"),
+		},
+		mem: []map[string]interface{}{
+			sample(map[string]string{"node": "node-1"}, "2147483648"),
+		},
+	}
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	src, err := NewPrometheus(srv.URL, PrometheusOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nodes, err := src.NodeMetrics(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(nodes) != 2 {
+		t.Fatalf("expected 2 nodes, got %d", len(nodes))
+	}
+
+	n1 := nodes["node-1"]
+	if got := n1.Usage.Cpu().MilliValue(); got != 1500 {
+		t.Errorf("expected node-1 CPU 1500m, got %dm", got)
+	}
+	if got := n1.Usage.Memory().Value(); got != 2*1024*1024*1024 {
+		t.Errorf("expected node-1 memory 2Gi, got %d", got)
+	}
+	if _, ok := nodes["node-2"].Usage[v1.ResourceMemory]; ok {
+		t.Error("expected node-2 to have no memory usage")
+	}
+
+	for _, q := range stub.queries {
+		if strings.Contains(q, "_over_time") {
+			t.Errorf("latest aggregation should not wrap queries, got %q", q)
+		}
+	}
+}
+
+func TestPrometheus_PodMetrics(t *testing.T) {
+	stub := &stubPrometheus{
+		cpu: []map[string]interface{}{
+			sample(map[string]string{"namespace": "payments", "pod": "api-0", "container": "app"}, "0.2"),
+			sample(map[string]string{"namespace": "payments", "pod": "api-0", "container": "sidecar"}, "0.05"),
+		},
+		mem: []map[string]interface{}{
+			sample(map[string]string{"namespace": "payments", "pod": "api-0", "container": "app"}, "104857600"),
+		},
+	}
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	src, err := NewPrometheus(srv.URL, PrometheusOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pods, err := src.PodMetrics(context.Background(), "payments")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pm, ok := pods[PodKey("payments", "api-0")]
+	if !ok {
+		t.Fatalf("expected metrics for payments/api-0, got keys %v", pods)
+	}
+	if len(pm.Containers) != 2 {
+		t.Fatalf("expected 2 containers, got %d", len(pm.Containers))
+	}
+	if pm.Containers[0].Name != "app" || pm.Containers[1].Name != "sidecar" {
+		t.Errorf("expected containers sorted by name, got %s, %s", pm.Containers[0].Name, pm.Containers[1].Name)
+	}
+	if got := pm.Containers[0].Usage.Cpu().MilliValue(); got != 200 {
+		t.Errorf("expected app CPU 200m, got %dm", got)
+	}
+	if got := pm.Containers[0].Usage.Memory().Value(); got != 100*1024*1024 {
+		t.Errorf("expected app memory 100Mi, got %d", got)
+	}
+
+	for _, q := range stub.queries {
+		if !strings.Contains(q, `namespace="payments"`) {
+			t.Errorf("expected namespace matcher in query %q", q)
+		}
+	}
+}
+
+func TestPrometheus_Aggregation(t *testing.T) {
+	tests := []struct {
+		agg  Aggregation
+		want string
+	}{
+		{AggregationAverage, "avg_over_time("},
+		{AggregationP95, "quantile_over_time(0.95, "},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.agg), func(t *testing.T) {
+			stub := &stubPrometheus{}
+			srv := httptest.NewServer(stub)
+			defer srv.Close()
+
+			src, err := NewPrometheus(srv.URL, PrometheusOptions{Window: time.Hour, Aggregation: tt.agg})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := src.NodeMetrics(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(stub.queries) != 2 {
+				t.Fatalf("expected 2 queries, got %d", len(stub.queries))
+			}
+			for _, q := range stub.queries {
+				if !strings.HasPrefix(q, tt.want) || !strings.HasSuffix(q, "[1h:1m])") {
+					t.Errorf("expected %s...[1h:1m]) query, got %q", tt.want, q)
+				}
+			}
+		})
+	}
+}
+
+func TestPrometheus_ErrorResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+	}))
+	defer srv.Close()
+
+	src, err := NewPrometheus(srv.URL, PrometheusOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = src.NodeMetrics(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "parse error") {
+		t.Errorf("expected prometheus error to be surfaced, got %v", err)
+	}
+}
+
+func TestNewPrometheus_Validation(t *testing.T) {
+	if _, err := NewPrometheus("", PrometheusOptions{}); err == nil {
+		t.Error("expected error for empty URL")
+	}
+	if _, err := NewPrometheus("prometheus:9090", PrometheusOptions{}); err == nil {
+		t.Error("expected error for URL without scheme")
+	}
+	if _, err := NewPrometheus("http://prometheus:9090", PrometheusOptions{Aggregation: AggregationP95}); err == nil {
+		t.Error("expected error for p95 without a window")
+	}
+}
+
+func TestParseAggregation(t *testing.T) {
+	for in, want := range map[string]Aggregation{"": AggregationLatest, "avg": AggregationAverage, "P95": AggregationP95} {
+		got, err := ParseAggregation(in)
+		if err != nil || got != want {
+			t.Errorf("ParseAggregation(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseAggregation("max"); err == nil {
+		t.Error("expected error for unsupported aggregation")
+	}
+}
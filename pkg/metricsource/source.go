@@ -0,0 +1,74 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metricsource provides pluggable backends for node and pod resource
+// usage. All backends return metrics.k8s.io types so that aggregation in
+// pkg/core and the CLI views stays independent of where usage comes from.
+package metricsource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// Supported metrics source names.
+const (
+	SourceMetricsServer = "metrics-server"
+	SourcePrometheus    = "prometheus"
+	SourceKubelet       = "kubelet"
+)
+
+// Source provides node and pod resource usage.
+type Source interface {
+	// Name returns a short, human-readable identifier for the backend.
+	Name() string
+	// NodeMetrics returns usage for all nodes, keyed by node name.
+	NodeMetrics(ctx context.Context) (map[string]*metricsv1beta1.NodeMetrics, error)
+	// PodMetrics returns per-container usage for pods in namespace (all
+	// namespaces when empty), keyed by PodKey(namespace, name).
+	PodMetrics(ctx context.Context, namespace string) (map[string]*metricsv1beta1.PodMetrics, error)
+}
+
+// PodKey returns the map key used by Source.PodMetrics for a pod.
+func PodKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// Aggregation controls how a backend with history reduces samples over its
+// window into a single value.
+type Aggregation string
+
+// Supported aggregations.
+const (
+	AggregationLatest  Aggregation = "latest"
+	AggregationAverage Aggregation = "avg"
+	AggregationP95     Aggregation = "p95"
+)
+
+// ParseAggregation validates an aggregation name. An empty string selects
+// AggregationLatest.
+func ParseAggregation(s string) (Aggregation, error) {
+	switch Aggregation(strings.ToLower(s)) {
+	case "", AggregationLatest:
+		return AggregationLatest, nil
+	case AggregationAverage:
+		return AggregationAverage, nil
+	case AggregationP95:
+		return AggregationP95, nil
+	default:
+		return "", fmt.Errorf("unknown metrics aggregation %q (expected latest, avg or p95)", s)
+	}
+}
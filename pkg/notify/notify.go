@@ -0,0 +1,291 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify turns periodic utilization observations into threshold
+// notifications. A Notifier tracks the level (ok, warn, critical) of every
+// node and namespace metric it is shown and sends an Event to its webhooks
+// when a level changes, at most once per cooldown for each metric.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Level is the state of an observed metric.
+type Level string
+
+// Levels, in increasing severity.
+const (
+	LevelOK       Level = "ok"
+	LevelWarn     Level = "warn"
+	LevelCritical Level = "critical"
+)
+
+// Subject kinds.
+const (
+	KindNode      = "node"
+	KindNamespace = "namespace"
+)
+
+// Metrics a Notifier can watch. Node percentages are relative to
+// allocatable; namespace usage is relative to the namespace's limits, as
+// in the live Namespaces view.
+const (
+	MetricCPUUsage       = "cpu.usagePercent"
+	MetricMemoryUsage    = "memory.usagePercent"
+	MetricCPURequests    = "cpu.requestsPercent"
+	MetricMemoryRequests = "memory.requestsPercent"
+)
+
+// Defaults applied by New to a zero Config.
+const (
+	DefaultCooldown = 10 * time.Minute
+	DefaultInterval = 30 * time.Second
+	DefaultWarn     = 75.0
+	DefaultCritical = 90.0
+)
+
+// queueSize bounds the events waiting for delivery. Observe drops events
+// rather than block the caller when receivers are slow.
+const queueSize = 256
+
+// Config configures a Notifier. It is read from the "notifications" key of
+// ~/.glance/config.
+type Config struct {
+	// Cooldown is the minimum time between two notifications for the same
+	// subject and metric. Transitions inside the cooldown are held back and
+	// sent afterwards if the level is still different from the last one sent.
+	Cooldown time.Duration `mapstructure:"cooldown"`
+	// Interval is how often callers evaluate the cluster.
+	Interval time.Duration `mapstructure:"interval"`
+	// Warn and Critical are the percentages at or above which a metric is
+	// at LevelWarn and LevelCritical.
+	Warn     float64 `mapstructure:"warn"`
+	Critical float64 `mapstructure:"critical"`
+	// Metrics lists the metrics to watch; empty watches CPU and memory usage.
+	Metrics  []string        `mapstructure:"metrics"`
+	Webhooks []WebhookConfig `mapstructure:"webhooks"`
+}
+
+// Observation is the current value of one metric of a node or namespace.
+type Observation struct {
+	Kind   string
+	Name   string
+	Metric string
+	Value  float64
+}
+
+// Event is a level transition of one metric.
+type Event struct {
+	Cluster  string  `json:"cluster,omitempty"`
+	Kind     string  `json:"kind"`
+	Name     string  `json:"name"`
+	Metric   string  `json:"metric"`
+	Value    float64 `json:"value"`
+	Previous Level   `json:"previous"`
+	Level    Level   `json:"level"`
+	// Threshold is the threshold that was crossed: that of Level, or of
+	// Previous when the metric recovered.
+	Threshold float64   `json:"threshold"`
+	Time      time.Time `json:"time"`
+}
+
+// Sender delivers events to one receiver.
+type Sender interface {
+	Name() string
+	Send(ctx context.Context, ev Event) error
+}
+
+// subjectState is what a Notifier remembers about one subject's metric.
+type subjectState struct {
+	notified Level // last level sent (LevelOK before the first event)
+	lastSent time.Time
+}
+
+// Notifier detects level transitions and hands them to its senders.
+type Notifier struct {
+	cfg     Config
+	metrics map[string]bool
+	senders []Sender
+	queue   chan Event
+	now     func() time.Time
+
+	mu    sync.Mutex
+	state map[string]*subjectState
+}
+
+// New validates cfg, applies defaults and returns a Notifier sending to
+// cfg.Webhooks.
+func New(cfg Config) (*Notifier, error) {
+	if cfg.Cooldown == 0 {
+		cfg.Cooldown = DefaultCooldown
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Warn == 0 {
+		cfg.Warn = DefaultWarn
+	}
+	if cfg.Critical == 0 {
+		cfg.Critical = DefaultCritical
+	}
+	if cfg.Cooldown < 0 || cfg.Interval < 0 {
+		return nil, fmt.Errorf("notifications: cooldown and interval must be positive")
+	}
+	if cfg.Warn > cfg.Critical {
+		return nil, fmt.Errorf("notifications: warn threshold %g is above critical threshold %g", cfg.Warn, cfg.Critical)
+	}
+	if len(cfg.Metrics) == 0 {
+		cfg.Metrics = []string{MetricCPUUsage, MetricMemoryUsage}
+	}
+	metrics := make(map[string]bool, len(cfg.Metrics))
+	for _, m := range cfg.Metrics {
+		switch m {
+		case MetricCPUUsage, MetricMemoryUsage, MetricCPURequests, MetricMemoryRequests:
+			metrics[m] = true
+		default:
+			return nil, fmt.Errorf("notifications: unknown metric %q", m)
+		}
+	}
+
+	senders := make([]Sender, 0, len(cfg.Webhooks))
+	for i, wh := range cfg.Webhooks {
+		s, err := NewWebhook(wh)
+		if err != nil {
+			return nil, fmt.Errorf("notifications: webhook %d: %w", i+1, err)
+		}
+		senders = append(senders, s)
+	}
+	return newNotifier(cfg, metrics, senders), nil
+}
+
+func newNotifier(cfg Config, metrics map[string]bool, senders []Sender) *Notifier {
+	return &Notifier{
+		cfg:     cfg,
+		metrics: metrics,
+		senders: senders,
+		queue:   make(chan Event, queueSize),
+		now:     time.Now,
+		state:   make(map[string]*subjectState),
+	}
+}
+
+// Interval returns how often the cluster should be observed.
+func (n *Notifier) Interval() time.Duration {
+	return n.cfg.Interval
+}
+
+// Watches reports whether metric is watched.
+func (n *Notifier) Watches(metric string) bool {
+	return n.metrics[metric]
+}
+
+// level returns the level of a value.
+func (n *Notifier) level(v float64) Level {
+	switch {
+	case v >= n.cfg.Critical:
+		return LevelCritical
+	case v >= n.cfg.Warn:
+		return LevelWarn
+	default:
+		return LevelOK
+	}
+}
+
+// threshold returns the percentage at which level starts.
+func (n *Notifier) threshold(level Level) float64 {
+	if level == LevelCritical {
+		return n.cfg.Critical
+	}
+	return n.cfg.Warn
+}
+
+// Observe records observations of cluster and queues an event for every
+// metric whose level differs from the last one notified, unless it was
+// notified within the cooldown. A subject starts at LevelOK, so a metric
+// already above a threshold is reported on its first observation. It
+// returns the queued events.
+func (n *Notifier) Observe(cluster string, observations []Observation) []Event {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := n.now()
+	var events []Event
+	for _, o := range observations {
+		if !n.metrics[o.Metric] {
+			continue
+		}
+		key := cluster + "/" + o.Kind + "/" + o.Name + "/" + o.Metric
+		st := n.state[key]
+		if st == nil {
+			st = &subjectState{notified: LevelOK}
+			n.state[key] = st
+		}
+
+		level := n.level(o.Value)
+		if level == st.notified {
+			continue
+		}
+		if !st.lastSent.IsZero() && now.Sub(st.lastSent) < n.cfg.Cooldown {
+			log.Debugf("notify: holding %s %s → %s during cooldown", key, st.notified, level)
+			continue
+		}
+
+		threshold := n.threshold(level)
+		if level == LevelOK || (level == LevelWarn && st.notified == LevelCritical) {
+			threshold = n.threshold(st.notified)
+		}
+		ev := Event{
+			Cluster:   cluster,
+			Kind:      o.Kind,
+			Name:      o.Name,
+			Metric:    o.Metric,
+			Value:     o.Value,
+			Previous:  st.notified,
+			Level:     level,
+			Threshold: threshold,
+			Time:      now,
+		}
+		select {
+		case n.queue <- ev:
+			st.notified, st.lastSent = level, now
+			events = append(events, ev)
+		default:
+			// Keep the old state so the transition is retried next time.
+			log.Warnf("notify: queue full, dropping %s %s → %s", key, st.notified, level)
+		}
+	}
+	return events
+}
+
+// Run delivers queued events to every sender until ctx is done. Delivery
+// errors are logged; events are not retried.
+func (n *Notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-n.queue:
+			for _, s := range n.senders {
+				if err := s.Send(ctx, ev); err != nil {
+					log.Warnf("notify: %s: %v", s.Name(), err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func observe(n *Notifier, value float64) []Event {
+	return n.Observe("prod", []Observation{{Kind: KindNode, Name: "node-1", Metric: MetricCPUUsage, Value: value}})
+}
+
+func TestNotifier_Transitions(t *testing.T) {
+	n, err := New(Config{Cooldown: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	n.now = func() time.Time { return now }
+
+	steps := []struct {
+		advance  time.Duration
+		value    float64
+		want     Level
+		previous Level
+	}{
+		{0, 50, "", ""},                             // ok on first sight: nothing to say
+		{0, 80, LevelWarn, LevelOK},                 // ok → warn
+		{time.Minute, 85, "", ""},                   // still warn: deduplicated
+		{time.Minute, 95, LevelCritical, LevelWarn}, // warn → critical
+		{10 * time.Second, 10, "", ""},              // recovered inside the cooldown: held
+		{time.Minute, 20, LevelOK, LevelCritical},   // held transition sent after the cooldown
+		{time.Minute, 90, LevelCritical, LevelOK},   // critical threshold is inclusive
+		{10 * time.Second, 95, "", ""},              // unchanged during cooldown
+		{10 * time.Second, 70, "", ""},              // flapped back and forth inside the cooldown
+		{10 * time.Second, 96, "", ""},              // ...and ended where it was: nothing sent
+		{time.Minute, 96, "", ""},
+	}
+	for i, s := range steps {
+		now = now.Add(s.advance)
+		events := observe(n, s.value)
+		if s.want == "" {
+			if len(events) != 0 {
+				t.Errorf("step %d: expected no event, got %+v", i, events)
+			}
+			continue
+		}
+		if len(events) != 1 || events[0].Level != s.want || events[0].Previous != s.previous {
+			t.Errorf("step %d: expected %s → %s, got %+v", i, s.previous, s.want, events)
+			continue
+		}
+		if events[0].Cluster != "prod" || events[0].Value != s.value || !events[0].Time.Equal(now) {
+			t.Errorf("step %d: unexpected event %+v", i, events[0])
+		}
+	}
+}
+
+func TestNotifier_Thresholds(t *testing.T) {
+	n, err := New(Config{Warn: 60, Critical: 80, Metrics: []string{MetricCPUUsage}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := n.Observe("", []Observation{
+		{Kind: KindNode, Name: "a", Metric: MetricCPUUsage, Value: 85},
+		{Kind: KindNamespace, Name: "a", Metric: MetricCPUUsage, Value: 65},
+		{Kind: KindNode, Name: "a", Metric: MetricMemoryUsage, Value: 99}, // not watched
+	})
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %+v", events)
+	}
+	if events[0].Level != LevelCritical || events[0].Threshold != 80 {
+		t.Errorf("unexpected node event %+v", events[0])
+	}
+	if events[1].Kind != KindNamespace || events[1].Level != LevelWarn || events[1].Threshold != 60 {
+		t.Errorf("unexpected namespace event %+v", events[1])
+	}
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	cases := map[string]Config{
+		"thresholds": {Warn: 95, Critical: 90},
+		"metric":     {Metrics: []string{"cpu.unknown"}},
+		"url":        {Webhooks: []WebhookConfig{{URL: "ftp://example.com"}}},
+		"format":     {Webhooks: []WebhookConfig{{URL: "http://example.com", Format: "teams"}}},
+		"cooldown":   {Cooldown: -time.Second},
+	}
+	for name, cfg := range cases {
+		if _, err := New(cfg); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestWebhook_Send(t *testing.T) {
+	var bodies []string
+	var auth string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		auth = r.Header.Get("Authorization")
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	ev := Event{Cluster: "prod", Kind: KindNode, Name: "node-1", Metric: MetricCPUUsage,
+		Value: 93.25, Previous: LevelWarn, Level: LevelCritical, Threshold: 90}
+
+	generic, err := NewWebhook(WebhookConfig{URL: srv.URL, Headers: map[string]string{"Authorization": "Bearer t"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := generic.Send(context.Background(), ev); err != nil {
+		t.Fatal(err)
+	}
+	var got Event
+	if err := json.Unmarshal([]byte(bodies[0]), &got); err != nil {
+		t.Fatalf("generic payload is not an event: %v", err)
+	}
+	if got.Name != "node-1" || got.Level != LevelCritical || got.Previous != LevelWarn || auth != "Bearer t" {
+		t.Errorf("unexpected generic delivery %+v (auth %q)", got, auth)
+	}
+
+	slack, err := NewWebhook(WebhookConfig{URL: srv.URL, Format: "slack"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := slack.Send(context.Background(), ev); err != nil {
+		t.Fatal(err)
+	}
+	var msg map[string]string
+	if err := json.Unmarshal([]byte(bodies[1]), &msg); err != nil {
+		t.Fatalf("slack payload: %v", err)
+	}
+	if len(msg) != 1 || !strings.Contains(msg["text"], "`node-1`") || !strings.Contains(msg["text"], "93.2%") ||
+		!strings.Contains(msg["text"], "warn → critical") {
+		t.Errorf("unexpected slack payload %q", bodies[1])
+	}
+
+	status = http.StatusInternalServerError
+	if err := generic.Send(context.Background(), ev); err == nil || !strings.Contains(err.Error(), "500") {
+		t.Errorf("expected status error, got %v", err)
+	}
+}
+
+func TestNotifier_Run(t *testing.T) {
+	received := make(chan Event, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev Event
+		_ = json.NewDecoder(r.Body).Decode(&ev)
+		received <- ev
+	}))
+	defer srv.Close()
+
+	n, err := New(Config{Webhooks: []WebhookConfig{{URL: srv.URL}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go n.Run(ctx)
+
+	observe(n, 99)
+	select {
+	case ev := <-received:
+		if ev.Level != LevelCritical || ev.Name != "node-1" {
+			t.Errorf("unexpected delivery %+v", ev)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not delivered")
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 David Arnold
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Webhook payload formats.
+const (
+	FormatGeneric = "generic"
+	FormatSlack   = "slack"
+)
+
+// webhookTimeout bounds a single delivery.
+const webhookTimeout = 10 * time.Second
+
+// WebhookConfig configures one webhook receiver.
+type WebhookConfig struct {
+	// Name identifies the webhook in logs; it defaults to the URL's host.
+	Name string `mapstructure:"name"`
+	URL  string `mapstructure:"url"`
+	// Format is "generic" (default), the Event as JSON, or "slack", a
+	// Slack-compatible {"text": ...} message.
+	Format string `mapstructure:"format"`
+	// Headers are added to every request, e.g. Authorization.
+	Headers map[string]string `mapstructure:"headers"`
+}
+
+// Webhook posts events as JSON to a URL.
+type Webhook struct {
+	name    string
+	url     string
+	format  string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhook validates cfg and returns its Webhook.
+func NewWebhook(cfg WebhookConfig) (*Webhook, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid url %q", cfg.URL)
+	}
+	format := strings.ToLower(cfg.Format)
+	switch format {
+	case "":
+		format = FormatGeneric
+	case FormatGeneric, FormatSlack:
+	default:
+		return nil, fmt.Errorf("unknown format %q (expected generic or slack)", cfg.Format)
+	}
+	name := cfg.Name
+	if name == "" {
+		name = u.Host
+	}
+	return &Webhook{
+		name:    name,
+		url:     cfg.URL,
+		format:  format,
+		headers: cfg.Headers,
+		client:  &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+// Name implements Sender.
+func (w *Webhook) Name() string { return "webhook " + w.name }
+
+// Send implements Sender. Any status other than 2xx is an error.
+func (w *Webhook) Send(ctx context.Context, ev Event) error {
+	var payload any = ev
+	if w.format == FormatSlack {
+		payload = map[string]string{"text": SlackText(ev)}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// SlackText renders ev as a one-line Slack message, e.g.
+// ":red_circle: *prod* node `node-1` cpu.usagePercent 93.2% (warn → critical, threshold 90%)".
+func SlackText(ev Event) string {
+	icon := ":large_green_circle:"
+	switch ev.Level {
+	case LevelCritical:
+		icon = ":red_circle:"
+	case LevelWarn:
+		icon = ":large_yellow_circle:"
+	}
+	cluster := ""
+	if ev.Cluster != "" {
+		cluster = "*" + ev.Cluster + "* "
+	}
+	return fmt.Sprintf("%s %s%s `%s` %s %.1f%% (%s → %s, threshold %g%%)",
+		icon, cluster, ev.Kind, ev.Name, ev.Metric, ev.Value, ev.Previous, ev.Level, ev.Threshold)
+}